@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"log"
+
+	"github.com/nedieyassin/pocketbase-gogen/generator"
+	"github.com/spf13/cobra"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check [schema path] [template path]",
+	Short: "Check a Template File for Drift from the PB Schema",
+	Long: `Compares a template file with the current PB schema and reports every difference.
+
+Arguments:
+  The schema path goes to the PB data directory (usually /pb_data) or a *.json file of the exported PB schema.
+
+  The template path goes to a *.go template file that was generated by the template command.
+
+The check reports added, removed and retyped collections and fields, changed select options and changed relation targets.
+Collections and fields are matched by the ids of their // collection-id: and // field-id: comments,
+so a collection or field that was renamed in PB is reported as renamed instead of removed and added.
+Without the id comments they are matched by their // collection-name: and // schema-name: comments.
+The command exits with a non-zero status when the template has drifted from the schema.`,
+	Run: runCheck,
+}
+
+func runCheck(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		log.Fatal("Two path arguments required. Use --help for more information.")
+	}
+
+	collections := importSchema(args[0])
+	templateSource := readTemplate(args[1])
+
+	parser, err := generator.NewTemplateParser(templateSource)
+	errCheck(err)
+
+	drift := generator.CheckTemplate(collections, parser)
+	if len(drift) == 0 {
+		log.Printf("The template %v matches the schema", args[1])
+		return
+	}
+
+	for _, d := range drift {
+		log.Print(d)
+	}
+	log.Fatalf("The template %v has drifted from the schema", args[1])
+}
@@ -15,6 +15,7 @@ var (
 	packageName   string
 	generateUtils bool
 	generateHooks bool
+	byIdFlag      bool
 
 	generateCmd = &cobra.Command{
 		Use:   "generate [input path] [output path]",
@@ -28,7 +29,10 @@ Arguments:
 	In this case the input path goes to the PB data directory (usually /pb_data) or a *.json file of the exported PB schema.
 
 	The output path specifies the *.go file name where the generated code will be saved. The package name will be derived from the directory name.
-	Use the --package flag to override the package name.`,
+	Use the --package flag to override the package name.
+
+	Use the --by-id flag to make the generated code find the collections by the ids of the '// collection-id:' comments.
+	The generated lookups and hooks then keep working after a collection is renamed in PocketBase.`,
 		Run: runGenerate,
 	}
 )
@@ -38,6 +42,7 @@ func init() {
 	generateCmd.Flags().StringVarP(&packageName, "package", "p", "", "Override the output directory name with a chosen package name")
 	generateCmd.Flags().BoolVarP(&generateUtils, "utils", "u", false, "Additionally generate utils.go next to the output file")
 	generateCmd.Flags().BoolVarP(&generateHooks, "hooks", "j", false, "Additionally generate proxy_events.go and proxy_hooks.go next to the output file (auto-enables --utils)")
+	generateCmd.Flags().BoolVarP(&byIdFlag, "by-id", "i", false, "Find the collections by their ids instead of their names")
 }
 
 func runGenerate(cmd *cobra.Command, args []string) {
@@ -68,6 +73,10 @@ func runGenerate(cmd *cobra.Command, args []string) {
 
 	parser, err := generator.NewTemplateParser(templateSource)
 	errCheck(err)
+	if byIdFlag {
+		err = parser.LookupCollectionsById()
+		errCheck(err)
+	}
 	sourceCode, err := generator.Generate(parser, args[1], packageName)
 	errCheck(err)
 
@@ -32,6 +32,7 @@ func init() {
 	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
 	rootCmd.AddCommand(templateCmd)
 	rootCmd.AddCommand(generateCmd)
+	rootCmd.AddCommand(checkCmd)
 }
 
 func Execute() {
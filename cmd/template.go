@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/nedieyassin/pocketbase-gogen/generator"
+	"github.com/pocketbase/pocketbase/core"
 	"github.com/spf13/cobra"
 )
 
@@ -23,12 +24,20 @@ Arguments:
 
 What is this template/schema as code for?
 
-The template is a go source file that contains human readable struct definitions for every collection in the PB schema. The file is not for compilation but you should place it inside your go project in a separate package that is never imported. The template is there so it can be edited before using the generate command. This gives control over the naming of the generated proxies. You can also add methods to the template structs and they will be transferred into the generated code. All field assignments and accesses will be replaced by the proxy getters and setters.`,
+The template is a go source file that contains human readable struct definitions for every collection in the PB schema. The file is not for compilation but you should place it inside your go project in a separate package that is never imported. The template is there so it can be edited before using the generate command. This gives control over the naming of the generated proxies. You can also add methods to the template structs and they will be transferred into the generated code. All field assignments and accesses will be replaced by the proxy getters and setters.
+
+
+Updating an edited template
+
+Use the --update flag when the output path goes to an existing template. Instead of overwriting it, the changes of the PB schema are merged into the template. New collections and fields are added, deleted ones are dropped and the select options and constraint comments are updated. Renamed structs and fields, select type and const names, comments and methods are kept.`,
 	Run: runTemplate,
 }
 
+var updateFlag bool
+
 func init() {
 	templateCmd.Flags().StringVarP(&packageName, "package", "p", "", "Override the output directory name with a chosen package name")
+	templateCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Merge the schema into the existing template at the output path instead of overwriting it")
 }
 
 func runTemplate(cmd *cobra.Command, args []string) {
@@ -38,6 +47,11 @@ func runTemplate(cmd *cobra.Command, args []string) {
 
 	collections := importSchema(args[0])
 
+	if updateFlag {
+		updateTemplate(collections, args[1])
+		return
+	}
+
 	outDir := filepath.Dir(args[1])
 	err := os.MkdirAll(outDir, os.ModePerm)
 	errCheck(err)
@@ -57,3 +71,15 @@ func runTemplate(cmd *cobra.Command, args []string) {
 
 	log.Printf("Saved the template to %v", args[1])
 }
+
+func updateTemplate(collections []*core.Collection, templatePath string) {
+	templateSource := readTemplate(templatePath)
+
+	sourceCode, err := generator.UpdateTemplate(collections, templateSource, templatePath)
+	errCheck(err)
+
+	err = os.WriteFile(templatePath, sourceCode, 0644)
+	errCheck(err)
+
+	log.Printf("Updated the template at %v", templatePath)
+}
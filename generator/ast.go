@@ -6,9 +6,12 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"strconv"
+	"strings"
 
 	"github.com/go-toolsmith/astcopy"
 	"github.com/iancoleman/strcase"
+	"github.com/pocketbase/pocketbase/core"
 	"golang.org/x/tools/go/ast/astutil"
 )
 
@@ -29,6 +32,72 @@ var (
 
 	collectionNameGetterTemplate *ast.FuncDecl
 
+	jsonGetterTemplate,
+	jsonErrGetterTemplate,
+	jsonErrSetterTemplate *ast.FuncDecl
+
+	fileSetterTemplate,
+	multiFileSetterTemplate,
+	multiFileAppendTemplate,
+	multiFileRemoveTemplate,
+	fileURLTemplate,
+	fileThumbURLTemplate,
+	multiFileURLsTemplate,
+	multiFileThumbURLsTemplate *ast.FuncDecl
+
+	validateTemplate,
+	constraintCheckTemplate,
+	fileSizeCheckTemplate,
+	emailDomainCheckTemplate,
+	urlDomainCheckTemplate *ast.FuncDecl
+
+	relationIdGetterTemplate,
+	relationIdSetterTemplate,
+	multiRelationIdsGetterTemplate,
+	multiRelationIdsSetterTemplate,
+	expandedRelationGetterTemplate,
+	expandedMultiRelationGetterTemplate,
+	relationLoaderTemplate,
+	multiRelationLoaderTemplate,
+	backRelationLoaderTemplate *ast.FuncDecl
+
+	selectErrGetterTemplate,
+	multiSelectErrGetterTemplate *ast.FuncDecl
+
+	stringSelectGetterTemplate,
+	multiStringSelectGetterTemplate,
+	stringSelectSetterTemplate,
+	multiStringSelectSetterTemplate,
+	stringSelectErrGetterTemplate,
+	multiStringSelectErrGetterTemplate *ast.FuncDecl
+
+	stringSelectMethodTemplates,
+	iotaSelectMethodTemplates []*ast.FuncDecl
+	selectOptionsFuncTemplate *ast.FuncDecl
+
+	multiRelationModifierTemplate,
+	multiRelationContainsTemplate,
+	multiSelectModifierTemplate,
+	multiStringSelectModifierTemplate,
+	numberIncrementTemplate *ast.FuncDecl
+
+	originalGetterTemplate,
+	changedCheckTemplate *ast.FuncDecl
+
+	fieldValuesTemplate   *ast.FuncDecl
+	fieldNamesTemplate    *ast.GenDecl
+	changedFieldsTemplate *ast.FuncDecl
+
+	patternTemplate *ast.GenDecl
+
+	verifySchemaTemplate,
+	verifySchemaOnBootstrapTemplate *ast.FuncDecl
+	verifySchemaUtilTemplates []ast.Decl
+
+	collectionSchemaTemplate *ast.GenDecl
+
+	authMethodTemplates []*ast.FuncDecl
+
 	proxyEventCodeTemplate []ast.Decl
 
 	proxyEventAliasTemplate,
@@ -42,6 +111,15 @@ var (
 	proxyHooksConstructorTemplate,
 	proxyHookRegistrationTemplate *ast.FuncDecl
 
+	updateHookAliasTemplate *ast.GenDecl
+
+	authEventAliasTemplates []*ast.GenDecl
+
+	proxyAuthHooksTemplate *ast.GenDecl
+
+	proxyAuthHooksConstructorTemplate,
+	proxyAuthHookRegistrationTemplate *ast.FuncDecl
+
 	proxyInterfaceTemplate,
 	proxyPInterfaceTemplate *ast.GenDecl
 
@@ -53,6 +131,25 @@ var (
 	relationFieldStructTemplate,
 	relationMapTemplate *ast.GenDecl
 
+	filterUtilTemplates []ast.Decl
+
+	filterBuilderTemplate *ast.GenDecl
+
+	finderUtilTemplates,
+	collectionFinderTemplates,
+	authUtilTemplates []ast.Decl
+
+	expandUtilTemplates []ast.Decl
+
+	expandTypeTemplate *ast.GenDecl
+	expandPathMethodTemplate,
+	expandRelationMethodTemplate *ast.FuncDecl
+	expandRootTemplate *ast.GenDecl
+
+	authGetterTemplate *ast.FuncDecl
+
+	fieldUtilTemplates []ast.Decl
+
 	primitiveGetters map[string]string
 )
 
@@ -76,10 +173,23 @@ type Field struct {
 	// Only set for system fields
 	systemFieldName string
 
+	// Only set for fields with a // field-id: comment
+	fieldId string
+
 	// Only set for select type fields
 	selectTypeName string
 	selectOptions  []string
 	selectVarNames []string
+	selectUnknown  string
+
+	// Only set for json fields with a // json: comment
+	jsonType ast.Expr
+
+	// True for file fields with a // file: comment
+	isFile bool
+
+	// Only set for fields with constraint comments
+	constraints *fieldConstraints
 
 	allProxyNames map[string]*ast.TypeSpec
 
@@ -91,11 +201,16 @@ func newField(
 	structName,
 	fieldName,
 	schemaName,
-	systemFieldName string,
+	systemFieldName,
+	fieldId string,
 	fieldType ast.Expr,
 	selectTypeName string,
 	selectOptions []string,
 	selectVarNames []string,
+	selectUnknown string,
+	jsonType ast.Expr,
+	isFile bool,
+	constraints *fieldConstraints,
 	allProxyNames map[string]*ast.TypeSpec,
 	astOriginal *ast.Field,
 	parser *Parser,
@@ -105,16 +220,39 @@ func newField(
 		fieldName:       fieldName,
 		schemaName:      schemaName,
 		systemFieldName: systemFieldName,
+		fieldId:         fieldId,
 		fieldType:       fieldType,
 		selectTypeName:  selectTypeName,
 		selectOptions:   selectOptions,
 		selectVarNames:  selectVarNames,
+		selectUnknown:   selectUnknown,
+		jsonType:        jsonType,
+		isFile:          isFile,
+		constraints:     constraints,
 		allProxyNames:   allProxyNames,
 		astOriginal:     astOriginal,
 		parser:          parser,
 	}
 }
 
+// Returns true if the field type is another proxy
+func (f *Field) isRelation() bool {
+	_, ok := f.allProxyNames[baseType(f.fieldType).Name]
+	return ok
+}
+
+// Returns true if the select field is backed by the
+// option strings instead of iota ordinals
+func (f *Field) isStringSelect() bool {
+	return f.selectTypeName != "" && baseType(f.fieldType).Name == "string"
+}
+
+// Fields of view collections can not be saved and
+// thus get no setters
+func (f *Field) isReadOnly() bool {
+	return f.parser.collectionTypes[f.structName] == core.CollectionTypeView
+}
+
 func loadTemplateASTs() error {
 	fset := token.NewFileSet()
 	opts := parser.SkipObjectResolution
@@ -140,6 +278,82 @@ func loadTemplateASTs() error {
 
 	collectionNameGetterTemplate = f.Decls[11].(*ast.FuncDecl)
 
+	jsonGetterTemplate = f.Decls[12].(*ast.FuncDecl)
+	jsonErrGetterTemplate = f.Decls[13].(*ast.FuncDecl)
+	jsonErrSetterTemplate = f.Decls[14].(*ast.FuncDecl)
+
+	fileSetterTemplate = f.Decls[15].(*ast.FuncDecl)
+	multiFileSetterTemplate = f.Decls[16].(*ast.FuncDecl)
+	multiFileAppendTemplate = f.Decls[17].(*ast.FuncDecl)
+	multiFileRemoveTemplate = f.Decls[18].(*ast.FuncDecl)
+	fileURLTemplate = f.Decls[19].(*ast.FuncDecl)
+	fileThumbURLTemplate = f.Decls[20].(*ast.FuncDecl)
+	multiFileURLsTemplate = f.Decls[21].(*ast.FuncDecl)
+	multiFileThumbURLsTemplate = f.Decls[22].(*ast.FuncDecl)
+
+	validateTemplate = f.Decls[23].(*ast.FuncDecl)
+	constraintCheckTemplate = f.Decls[24].(*ast.FuncDecl)
+	fileSizeCheckTemplate = f.Decls[25].(*ast.FuncDecl)
+	emailDomainCheckTemplate = f.Decls[26].(*ast.FuncDecl)
+	urlDomainCheckTemplate = f.Decls[27].(*ast.FuncDecl)
+
+	relationIdGetterTemplate = f.Decls[28].(*ast.FuncDecl)
+	relationIdSetterTemplate = f.Decls[29].(*ast.FuncDecl)
+	multiRelationIdsGetterTemplate = f.Decls[30].(*ast.FuncDecl)
+	multiRelationIdsSetterTemplate = f.Decls[31].(*ast.FuncDecl)
+	expandedRelationGetterTemplate = f.Decls[32].(*ast.FuncDecl)
+	expandedMultiRelationGetterTemplate = f.Decls[33].(*ast.FuncDecl)
+	relationLoaderTemplate = f.Decls[34].(*ast.FuncDecl)
+	multiRelationLoaderTemplate = f.Decls[35].(*ast.FuncDecl)
+	backRelationLoaderTemplate = f.Decls[36].(*ast.FuncDecl)
+
+	selectErrGetterTemplate = f.Decls[37].(*ast.FuncDecl)
+	multiSelectErrGetterTemplate = f.Decls[38].(*ast.FuncDecl)
+
+	stringSelectGetterTemplate = f.Decls[39].(*ast.FuncDecl)
+	multiStringSelectGetterTemplate = f.Decls[40].(*ast.FuncDecl)
+	stringSelectSetterTemplate = f.Decls[41].(*ast.FuncDecl)
+	multiStringSelectSetterTemplate = f.Decls[42].(*ast.FuncDecl)
+	stringSelectErrGetterTemplate = f.Decls[43].(*ast.FuncDecl)
+	multiStringSelectErrGetterTemplate = f.Decls[44].(*ast.FuncDecl)
+
+	// Both kinds of select types share the json and sql methods
+	sharedSelectMethodTemplates := funcDecls(f.Decls[50:54])
+	stringSelectMethodTemplates = append(funcDecls(f.Decls[45:50]), sharedSelectMethodTemplates...)
+	iotaSelectMethodTemplates = append(funcDecls(f.Decls[54:57]), sharedSelectMethodTemplates...)
+	selectOptionsFuncTemplate = f.Decls[57].(*ast.FuncDecl)
+
+	multiRelationModifierTemplate = f.Decls[58].(*ast.FuncDecl)
+	multiRelationContainsTemplate = f.Decls[59].(*ast.FuncDecl)
+	multiSelectModifierTemplate = f.Decls[60].(*ast.FuncDecl)
+	multiStringSelectModifierTemplate = f.Decls[61].(*ast.FuncDecl)
+	numberIncrementTemplate = f.Decls[62].(*ast.FuncDecl)
+
+	originalGetterTemplate = f.Decls[63].(*ast.FuncDecl)
+	changedCheckTemplate = f.Decls[64].(*ast.FuncDecl)
+
+	verifySchemaTemplate = f.Decls[65].(*ast.FuncDecl)
+	verifySchemaOnBootstrapTemplate = f.Decls[66].(*ast.FuncDecl)
+	verifySchemaUtilTemplates = f.Decls[67:71]
+	collectionSchemaTemplate = f.Decls[71].(*ast.GenDecl)
+
+	fieldValuesTemplate = f.Decls[72].(*ast.FuncDecl)
+	fieldNamesTemplate = f.Decls[73].(*ast.GenDecl)
+	changedFieldsTemplate = f.Decls[74].(*ast.FuncDecl)
+
+	patternTemplate = f.Decls[75].(*ast.GenDecl)
+
+	f, err = parser.ParseFile(fset, ".", proxyAuthTemplateCode, opts)
+	if err != nil {
+		return err
+	}
+
+	authMethodTemplates = make([]*ast.FuncDecl, len(f.Decls))
+	for i, decl := range f.Decls {
+		authMethodTemplates[i] = decl.(*ast.FuncDecl)
+	}
+
+	opts |= parser.ParseComments
 	f, err = parser.ParseFile(fset, ".", proxyEventsTemplateCode, opts)
 	if err != nil {
 		return err
@@ -147,7 +361,6 @@ func loadTemplateASTs() error {
 
 	proxyEventCodeTemplate = f.Decls
 
-	opts |= parser.ParseComments
 	f, err = parser.ParseFile(fset, ".", proxyHooksTemplateCode, opts)
 	if err != nil {
 		return err
@@ -163,6 +376,17 @@ func loadTemplateASTs() error {
 
 	proxyHooksConstructorTemplate = f.Decls[6].(*ast.FuncDecl)
 	proxyHookRegistrationTemplate = f.Decls[7].(*ast.FuncDecl)
+	updateHookAliasTemplate = f.Decls[8].(*ast.GenDecl)
+
+	authEventAliasTemplates = make([]*ast.GenDecl, 12)
+	for i := range authEventAliasTemplates {
+		authEventAliasTemplates[i] = f.Decls[9+i].(*ast.GenDecl)
+	}
+
+	proxyAuthHooksTemplate = f.Decls[21].(*ast.GenDecl)
+
+	proxyAuthHooksConstructorTemplate = f.Decls[22].(*ast.FuncDecl)
+	proxyAuthHookRegistrationTemplate = f.Decls[23].(*ast.FuncDecl)
 
 	f, err = parser.ParseFile(fset, ".", utilTemplateCode, opts)
 	if err != nil {
@@ -178,10 +402,32 @@ func loadTemplateASTs() error {
 	wrapRecordsUtilTemplate = f.Decls[5].(*ast.FuncDecl)
 	relationFieldStructTemplate = f.Decls[6].(*ast.GenDecl)
 	relationMapTemplate = f.Decls[7].(*ast.GenDecl)
+	filterUtilTemplates = f.Decls[8:30]
+	filterBuilderTemplate = f.Decls[30].(*ast.GenDecl)
+	finderUtilTemplates = f.Decls[31:36]
+	collectionFinderTemplates = f.Decls[36:41]
+	expandUtilTemplates = f.Decls[41:44]
+	expandTypeTemplate = f.Decls[44].(*ast.GenDecl)
+	expandPathMethodTemplate = f.Decls[45].(*ast.FuncDecl)
+	expandRelationMethodTemplate = f.Decls[46].(*ast.FuncDecl)
+	expandRootTemplate = f.Decls[47].(*ast.GenDecl)
+
+	authUtilTemplates = f.Decls[48:51]
+	authGetterTemplate = f.Decls[51].(*ast.FuncDecl)
+
+	fieldUtilTemplates = f.Decls[52:56]
 
 	return nil
 }
 
+func funcDecls(decls []ast.Decl) []*ast.FuncDecl {
+	funcs := make([]*ast.FuncDecl, len(decls))
+	for i, decl := range decls {
+		funcs[i] = decl.(*ast.FuncDecl)
+	}
+	return funcs
+}
+
 func loadPBInfo() error {
 	info, err := newPocketBaseInfo()
 	if err != nil {
@@ -204,6 +450,9 @@ func newGetterDecl(field *Field) (*ast.FuncDecl, error) {
 	if field.selectTypeName != "" {
 		return newSelectGetterDecl(field)
 	}
+	if field.jsonType != nil {
+		return newJSONFuncDecl(field, jsonGetterTemplate, getterName(field.fieldName))
+	}
 
 	returnTypeName, err := nodeString(field.fieldType)
 	if err != nil {
@@ -286,9 +535,14 @@ func newRelGetterDecl(field *Field) (*ast.FuncDecl, error) {
 
 func newSelectGetterDecl(field *Field) (*ast.FuncDecl, error) {
 	var decl *ast.FuncDecl
-	if relationType(field.fieldType) == singleRel {
+	switch {
+	case field.isStringSelect() && relationType(field.fieldType) == singleRel:
+		decl = astcopy.FuncDecl(stringSelectGetterTemplate)
+	case field.isStringSelect():
+		decl = astcopy.FuncDecl(multiStringSelectGetterTemplate)
+	case relationType(field.fieldType) == singleRel:
 		decl = astcopy.FuncDecl(selectGetterTemplate)
-	} else {
+	default:
 		decl = astcopy.FuncDecl(multiSelectGetterTemplate)
 	}
 
@@ -305,6 +559,16 @@ func newSelectGetterDecl(field *Field) (*ast.FuncDecl, error) {
 		return nil, err
 	}
 
+	var onUnknown ast.Stmt = &ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("Unset")}}
+	if relationType(field.fieldType) == multiRel {
+		onUnknown = &ast.BranchStmt{Tok: token.CONTINUE}
+	}
+	if field.isStringSelect() && field.selectUnknown == selectUnknownRaw {
+		// String options can be returned as they are
+		onUnknown = nil
+	}
+	adaptSelectTemplate(decl, field, onUnknown)
+
 	return decl, nil
 }
 
@@ -327,14 +591,46 @@ func newCollectionNameGetter(getterName, structName, collectionName string) (*as
 	return decl, nil
 }
 
+// Creates the methods of an auth collection proxy
+// that delegate to the auth methods of core.Record
+func newAuthMethodDecls(structName string) ([]*ast.FuncDecl, error) {
+	decls := make([]*ast.FuncDecl, len(authMethodTemplates))
+	for i, template := range authMethodTemplates {
+		decl := astcopy.FuncDecl(template)
+		err := adaptFuncTemplate(decl, structName, decl.Name.Name, "", "", "", nil)
+		if err != nil {
+			return nil, err
+		}
+		decls[i] = decl
+	}
+	return decls, nil
+}
+
+// Returns the names of the auth methods. An auth proxy
+// can shadow these core.Record names safely.
+func authMethodNames() map[string]any {
+	names := make(map[string]any, len(authMethodTemplates))
+	for _, template := range authMethodTemplates {
+		names[template.Name.Name] = nil
+	}
+	return names
+}
+
+// Returns nil for read-only fields
 func newSetterDecl(field *Field) (*ast.FuncDecl, error) {
+	if field.isReadOnly() {
+		return nil, nil
+	}
+
 	fieldName := field.fieldName
 	fieldType := field.fieldType
 
 	var decl *ast.FuncDecl
 
-	switch field.selectTypeName {
-	case "":
+	switch {
+	case field.jsonType != nil:
+		return newJSONFuncDecl(field, setterTemplate, setterName(fieldName))
+	case field.selectTypeName == "":
 		returnTypeName, err := nodeString(fieldType)
 		if err != nil {
 			return nil, err
@@ -346,10 +642,14 @@ func newSetterDecl(field *Field) (*ast.FuncDecl, error) {
 		decl = astcopy.FuncDecl(setterTemplate)
 	default:
 		fieldType = &ast.Ident{Name: field.selectTypeName}
-		switch relationType(field.fieldType) {
-		case singleRel:
+		switch {
+		case field.isStringSelect() && relationType(field.fieldType) == singleRel:
+			decl = astcopy.FuncDecl(stringSelectSetterTemplate)
+		case field.isStringSelect():
+			decl = astcopy.FuncDecl(multiStringSelectSetterTemplate)
+		case relationType(field.fieldType) == singleRel:
 			decl = astcopy.FuncDecl(selectSetterTemplate)
-		case multiRel:
+		default:
 			decl = astcopy.FuncDecl(multiSelectSetterTemplate)
 		}
 	}
@@ -367,6 +667,586 @@ func newSetterDecl(field *Field) (*ast.FuncDecl, error) {
 		return nil, err
 	}
 
+	if field.selectTypeName != "" {
+		adaptSelectSetterTemplate(decl, field)
+	}
+
+	return decl, nil
+}
+
+// Creates the additional accessors of a field that come
+// on top of the getter/setter pair. Returns nil if the field
+// does not have any.
+func newAccessorVariantDecls(field *Field) ([]*ast.FuncDecl, error) {
+	var variants []*ast.FuncDecl
+	var err error
+	switch {
+	case field.jsonType != nil:
+		variants, err = newJSONVariantDecls(field)
+	case field.isFile:
+		variants, err = newFileVariantDecls(field)
+	case field.isRelation():
+		variants, err = newRelationVariantDecls(field)
+	case field.selectTypeName != "":
+		variants, err = newSelectVariantDecls(field)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	modifiers, err := newModifierDecls(field)
+	if err != nil {
+		return nil, err
+	}
+	changeTracking, err := newChangeTrackingDecls(field)
+	if err != nil {
+		return nil, err
+	}
+
+	variants = append(variants, modifiers...)
+	return append(variants, changeTracking...), nil
+}
+
+// Creates the getter of the original field value and the
+// check if the field changed. Relations compare their ids
+// because the original record has no expanded relations.
+// Returns nil for fields of view collections.
+func newChangeTrackingDecls(field *Field) ([]*ast.FuncDecl, error) {
+	if field.isReadOnly() {
+		return nil, nil
+	}
+
+	name := getterName(field.fieldName)
+	getterFuncName, valueType, err := trackedValueGetter(field)
+	if err != nil {
+		return nil, err
+	}
+
+	original := astcopy.FuncDecl(originalGetterTemplate)
+	err = adaptFuncTemplate(
+		original,
+		field.structName,
+		"Original"+getterFuncName,
+		getterFuncName,
+		field.fieldName,
+		field.schemaName,
+		valueType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := astcopy.FuncDecl(changedCheckTemplate)
+	err = adaptFuncTemplate(changed, field.structName, name+"Changed", "", field.fieldName, field.schemaName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*ast.FuncDecl{original, changed}, nil
+}
+
+// Creates the methods that use PocketBase's field+ and field-
+// modifiers to change multi relations, multi selects and numbers
+// without replacing the whole value. Returns nil for other fields.
+func newModifierDecls(field *Field) ([]*ast.FuncDecl, error) {
+	if field.isReadOnly() || field.jsonType != nil || field.isFile {
+		return nil, nil
+	}
+
+	name := getterName(field.fieldName)
+	fieldType := field.fieldType
+
+	typeName, err := nodeString(field.fieldType)
+	if err != nil {
+		return nil, err
+	}
+	isMulti := relationType(field.fieldType) == multiRel
+
+	var templates []*ast.FuncDecl
+	var funcNames []string
+	switch {
+	case field.isRelation() && isMulti:
+		fieldType = baseType(field.fieldType)
+		templates = []*ast.FuncDecl{
+			multiRelationModifierTemplate,
+			multiRelationModifierTemplate,
+			multiRelationContainsTemplate,
+		}
+		funcNames = []string{"Add" + name, "Remove" + name, "Has" + singular(name)}
+	case field.isStringSelect() && isMulti:
+		fieldType = ast.NewIdent(field.selectTypeName)
+		templates = []*ast.FuncDecl{multiStringSelectModifierTemplate, multiStringSelectModifierTemplate}
+		funcNames = []string{"Add" + name, "Remove" + name}
+	case field.selectTypeName != "" && isMulti:
+		fieldType = ast.NewIdent(field.selectTypeName)
+		templates = []*ast.FuncDecl{multiSelectModifierTemplate, multiSelectModifierTemplate}
+		funcNames = []string{"Add" + name, "Remove" + name}
+	case field.selectTypeName == "" && (typeName == "int" || typeName == "float64"):
+		templates = []*ast.FuncDecl{numberIncrementTemplate}
+		funcNames = []string{"Increment" + name}
+	default:
+		return nil, nil
+	}
+
+	decls := make([]*ast.FuncDecl, len(templates))
+	for i, template := range templates {
+		decl := astcopy.FuncDecl(template)
+		if strings.HasPrefix(funcNames[i], "Remove") {
+			replaceStringLits(decl, map[string]string{"key+": "key-"})
+		}
+		err := adaptFuncTemplate(
+			decl,
+			field.structName,
+			funcNames[i],
+			"",
+			field.fieldName,
+			field.schemaName,
+			fieldType,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if field.selectTypeName != "" {
+			adaptSelectSetterTemplate(decl, field)
+		}
+		decls[i] = decl
+	}
+
+	return decls, nil
+}
+
+// Returns the name and the value type of the getter whose
+// original value is tracked. Relations are tracked by their ids.
+func trackedValueGetter(field *Field) (string, ast.Expr, error) {
+	name := getterName(field.fieldName)
+	switch {
+	case field.isRelation() && relationType(field.fieldType) == singleRel:
+		return name + "Id", ast.NewIdent("string"), nil
+	case field.isRelation():
+		return name + "Ids", &ast.ArrayType{Elt: ast.NewIdent("string")}, nil
+	}
+
+	getter, err := newGetterDecl(field)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, getter.Type.Results.List[0].Type, nil
+}
+
+// Returns a naive singular form of an english plural
+// like Children, Tags or Addresses. Names that might
+// already be singular like Status or Alias are kept.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "hildren"):
+		return strings.TrimSuffix(name, "ren")
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"),
+		strings.HasSuffix(name, "shes"),
+		strings.HasSuffix(name, "xes"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "ss"),
+		strings.HasSuffix(name, "us"),
+		strings.HasSuffix(name, "is"),
+		strings.HasSuffix(name, "as"):
+		return name
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
+
+func newJSONVariantDecls(field *Field) ([]*ast.FuncDecl, error) {
+	getter, err := newJSONFuncDecl(field, jsonErrGetterTemplate, getterName(field.fieldName)+"E")
+	if err != nil {
+		return nil, err
+	}
+	if field.isReadOnly() {
+		return []*ast.FuncDecl{getter}, nil
+	}
+	setter, err := newJSONFuncDecl(field, jsonErrSetterTemplate, setterName(field.fieldName)+"E")
+	if err != nil {
+		return nil, err
+	}
+
+	return []*ast.FuncDecl{getter, setter}, nil
+}
+
+func newFileVariantDecls(field *Field) ([]*ast.FuncDecl, error) {
+	name := getterName(field.fieldName)
+
+	var templates []*ast.FuncDecl
+	var funcNames []string
+	switch relationType(field.fieldType) {
+	case singleRel:
+		templates = []*ast.FuncDecl{fileSetterTemplate, fileURLTemplate, fileThumbURLTemplate}
+		funcNames = []string{"Set" + name + "File", name + "URL", name + "ThumbURL"}
+	case multiRel:
+		templates = []*ast.FuncDecl{
+			multiFileSetterTemplate,
+			multiFileAppendTemplate,
+			multiFileRemoveTemplate,
+			multiFileURLsTemplate,
+			multiFileThumbURLsTemplate,
+		}
+		funcNames = []string{
+			"Set" + name + "Files",
+			"Append" + name,
+			"Remove" + name,
+			name + "URLs",
+			name + "ThumbURLs",
+		}
+	}
+	if field.isReadOnly() {
+		// Only keep the URL builders
+		templates = templates[len(templates)-2:]
+		funcNames = funcNames[len(funcNames)-2:]
+	}
+
+	decls := make([]*ast.FuncDecl, len(templates))
+	for i, template := range templates {
+		decl := astcopy.FuncDecl(template)
+		err := adaptFuncTemplate(
+			decl,
+			field.structName,
+			funcNames[i],
+			"",
+			field.fieldName,
+			field.schemaName,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		decls[i] = decl
+	}
+
+	return decls, nil
+}
+
+func newSelectVariantDecls(field *Field) ([]*ast.FuncDecl, error) {
+	name := getterName(field.fieldName)
+
+	var errGetter *ast.FuncDecl
+	var onUnknown ast.Stmt
+	switch {
+	case field.isStringSelect() && field.selectUnknown == selectUnknownRaw:
+		// The getter already returns unknown options as they are
+		return nil, nil
+	case field.isStringSelect() && relationType(field.fieldType) == singleRel:
+		errGetter = astcopy.FuncDecl(stringSelectErrGetterTemplate)
+	case field.isStringSelect():
+		errGetter = astcopy.FuncDecl(multiStringSelectErrGetterTemplate)
+	case relationType(field.fieldType) == singleRel:
+		errGetter = astcopy.FuncDecl(selectErrGetterTemplate)
+		onUnknown = &ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("Unset"), ast.NewIdent("nil")}}
+	default:
+		errGetter = astcopy.FuncDecl(multiSelectErrGetterTemplate)
+		onUnknown = &ast.BranchStmt{Tok: token.CONTINUE}
+	}
+
+	err := adaptFuncTemplate(
+		errGetter,
+		field.structName,
+		name+"E",
+		"",
+		field.fieldName,
+		field.schemaName,
+		ast.NewIdent(field.selectTypeName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if field.selectUnknown != selectUnknownRaw {
+		replaceIdents(errGetter, map[string]string{"Unset": selectUnsetName(field.selectTypeName)})
+		return []*ast.FuncDecl{errGetter}, nil
+	}
+
+	// Unknown options are no errors and stay readable as raw strings
+	astutil.Apply(errGetter, func(c *astutil.Cursor) bool {
+		if ret, ok := c.Node().(*ast.ReturnStmt); ok && isErrorfCall(ret) {
+			c.Replace(onUnknown)
+		}
+		return true
+	}, nil)
+	replaceIdents(errGetter, map[string]string{"Unset": selectUnsetName(field.selectTypeName)})
+
+	rawTemplate := relationIdGetterTemplate
+	if relationType(field.fieldType) == multiRel {
+		rawTemplate = multiRelationIdsGetterTemplate
+	}
+	rawGetter := astcopy.FuncDecl(rawTemplate)
+	err = adaptFuncTemplate(
+		rawGetter,
+		field.structName,
+		name+"Raw",
+		"",
+		field.fieldName,
+		field.schemaName,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*ast.FuncDecl{errGetter, rawGetter}, nil
+}
+
+// Replaces the Unset placeholder of a select accessor template
+// and, unless the field panics on unknown options, its panic
+// statement with onUnknown. A nil onUnknown removes the whole
+// check of the option.
+func adaptSelectTemplate(decl *ast.FuncDecl, field *Field, onUnknown ast.Stmt) {
+	if field.selectUnknown != selectUnknownPanic {
+		astutil.Apply(decl, func(c *astutil.Cursor) bool {
+			switch n := c.Node().(type) {
+			case *ast.IfStmt:
+				if onUnknown == nil && isUnknownOptionCheck(n) {
+					c.Delete()
+					return false
+				}
+			case *ast.ExprStmt:
+				if isPanicCall(n.X) {
+					c.Replace(onUnknown)
+				}
+			}
+			return true
+		}, nil)
+	}
+	replaceIdents(decl, map[string]string{"Unset": selectUnsetName(field.selectTypeName)})
+}
+
+// Replaces the Unset placeholder of a select setter or modifier
+// template. Writing an invalid option panics under every policy
+// because the write would otherwise be lost without notice. Only
+// string selects with the raw policy store any option as it is.
+func adaptSelectSetterTemplate(decl *ast.FuncDecl, field *Field) {
+	if field.isStringSelect() && field.selectUnknown == selectUnknownRaw {
+		adaptSelectTemplate(decl, field, nil)
+		return
+	}
+	replaceIdents(decl, map[string]string{"Unset": selectUnsetName(field.selectTypeName)})
+}
+
+// Returns true for the if statements of the select templates
+// that handle an unknown option with a panic or an error
+func isUnknownOptionCheck(stmt *ast.IfStmt) bool {
+	if len(stmt.Body.List) != 1 {
+		return false
+	}
+	switch n := stmt.Body.List[0].(type) {
+	case *ast.ExprStmt:
+		return isPanicCall(n.X)
+	case *ast.ReturnStmt:
+		return isErrorfCall(n)
+	}
+	return false
+}
+
+func isPanicCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := call.Fun.(*ast.Ident)
+	return ok && ident.Name == "panic"
+}
+
+func isErrorfCall(ret *ast.ReturnStmt) bool {
+	if len(ret.Results) != 2 {
+		return false
+	}
+	call, ok := ret.Results[1].(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && selector.Sel.Name == "Errorf"
+}
+
+func newRelationVariantDecls(field *Field) ([]*ast.FuncDecl, error) {
+	name := getterName(field.fieldName)
+
+	var templates []*ast.FuncDecl
+	var funcNames []string
+	switch relationType(field.fieldType) {
+	case singleRel:
+		templates = []*ast.FuncDecl{
+			relationIdGetterTemplate,
+			expandedRelationGetterTemplate,
+			relationIdSetterTemplate,
+		}
+		funcNames = []string{name + "Id", name + "Expanded", "Set" + name + "Id"}
+	case multiRel:
+		templates = []*ast.FuncDecl{
+			multiRelationIdsGetterTemplate,
+			expandedMultiRelationGetterTemplate,
+			multiRelationIdsSetterTemplate,
+		}
+		funcNames = []string{name + "Ids", name + "Expanded", "Set" + name + "Ids"}
+	}
+	if field.isReadOnly() {
+		// Drop the id setter
+		templates = templates[:2]
+		funcNames = funcNames[:2]
+	}
+
+	decls := make([]*ast.FuncDecl, len(templates), len(templates)+1)
+	for i, template := range templates {
+		decl := astcopy.FuncDecl(template)
+		err := adaptFuncTemplate(
+			decl,
+			field.structName,
+			funcNames[i],
+			"",
+			field.fieldName,
+			field.schemaName,
+			baseType(field.fieldType),
+		)
+		if err != nil {
+			return nil, err
+		}
+		decls[i] = decl
+	}
+
+	loader, err := newRelationLoaderDecl(field)
+	if err != nil {
+		return nil, err
+	}
+	if loader != nil {
+		decls = append(decls, loader)
+	}
+
+	return decls, nil
+}
+
+// Creates the method that fetches the related records from the app.
+// Returns nil if the related proxy has no collection name to fetch from.
+func newRelationLoaderDecl(field *Field) (*ast.FuncDecl, error) {
+	relType := baseType(field.fieldType)
+	collectionName := field.parser.collectionNames[relType.Name]
+	if collectionName == "" {
+		return nil, nil
+	}
+
+	var decl *ast.FuncDecl
+	switch relationType(field.fieldType) {
+	case singleRel:
+		decl = astcopy.FuncDecl(relationLoaderTemplate)
+	case multiRel:
+		decl = astcopy.FuncDecl(multiRelationLoaderTemplate)
+	}
+
+	err := adaptFuncTemplate(
+		decl,
+		field.structName,
+		"Load"+getterName(field.fieldName),
+		"",
+		field.fieldName,
+		field.schemaName,
+		relType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// PocketBase names can not contain the angle brackets of the
+	// placeholder so a field named "collection" is left alone
+	collectionRef := field.parser.collectionRef(relType.Name)
+	replaceStringLits(decl, map[string]string{"<collection>": collectionRef})
+
+	return decl, nil
+}
+
+// Creates the getter and the loader of the records that point to
+// the proxy through the relation field of another proxy.
+// The methods are named like PersonViaAccount after the PocketBase
+// expand key person_via_account.
+func newBackRelationDecls(structName string, relField *Field) ([]*ast.FuncDecl, error) {
+	collectionName := relField.parser.collectionNames[relField.structName]
+	expandKey := collectionName + "_via_" + relField.schemaName
+	funcName := relField.structName + "Via" + getterName(relField.fieldName)
+	relType := ast.NewIdent(relField.structName)
+
+	getter := astcopy.FuncDecl(multiRelationGetterTemplate)
+	err := adaptFuncTemplate(getter, structName, funcName, "", relField.fieldName, expandKey, relType)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := astcopy.FuncDecl(backRelationLoaderTemplate)
+	err = adaptFuncTemplate(loader, structName, "Load"+funcName, "", relField.fieldName, expandKey, relType)
+	if err != nil {
+		return nil, err
+	}
+	filter := relField.schemaName + " = {:id}"
+	if relationType(relField.fieldType) == multiRel {
+		filter = relField.schemaName + ":each ?= {:id}"
+	}
+	replaceStringLits(loader, map[string]string{"<collection>": collectionName, "<filter>": filter})
+
+	return []*ast.FuncDecl{getter, loader}, nil
+}
+
+// Replaces the part of all identifier names in the node
+// that match old with new. Turns FindStructNameById into
+// FindPersonById for example.
+func replaceIdentParts(node ast.Node, old, new string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			ident.Name = strings.Replace(ident.Name, old, new, 1)
+		}
+		return true
+	})
+}
+
+// Replaces identifiers in the node whose names exactly match a key
+func replaceIdents(node ast.Node, replacements map[string]string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if replacement, ok := replacements[ident.Name]; ok {
+				ident.Name = replacement
+			}
+		}
+		return true
+	})
+}
+
+// Replaces the values of string literals in the node
+func replaceStringLits(node ast.Node, replacements map[string]string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+		if replacement, ok := replacements[value]; ok {
+			lit.Value = strconv.Quote(replacement)
+		}
+		return true
+	})
+}
+
+func newJSONFuncDecl(field *Field, template *ast.FuncDecl, funcName string) (*ast.FuncDecl, error) {
+	decl := astcopy.FuncDecl(template)
+
+	err := adaptFuncTemplate(
+		decl,
+		field.structName,
+		funcName,
+		"",
+		field.fieldName,
+		field.schemaName,
+		field.jsonType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return decl, nil
 }
 
@@ -452,12 +1332,19 @@ func adaptFuncTemplate(
 				}
 				n.Name = selectIotaMapName(baseTypeName)
 			case "FieldType":
-				c.Replace(fieldType)
+				// Copy so that no node is shared between positions
+				c.Replace(astcopy.Expr(fieldType))
 			}
 		case *ast.BasicLit:
-			if n.Value == "\"key\"" {
-				keyLiteral := fmt.Sprintf("\"%v\"", schemaFieldName)
-				n.Value = keyLiteral
+			switch n.Value {
+			case "\"key\"":
+				n.Value = fmt.Sprintf("\"%v\"", schemaFieldName)
+			case "\"key+\"":
+				// PocketBase's append modifier
+				n.Value = fmt.Sprintf("\"%v+\"", schemaFieldName)
+			case "\"key-\"":
+				// PocketBase's remove modifier
+				n.Value = fmt.Sprintf("\"%v-\"", schemaFieldName)
 			}
 		}
 		return true
@@ -468,10 +1355,10 @@ func adaptFuncTemplate(
 	return adapterErr
 }
 
-func newSelectTypeDecl(name string) *ast.GenDecl {
+func newSelectTypeDecl(name, underlyingType string) *ast.GenDecl {
 	spec := &ast.TypeSpec{
 		Name: &ast.Ident{Name: name},
-		Type: &ast.Ident{Name: "int"},
+		Type: &ast.Ident{Name: underlyingType},
 	}
 	decl := &ast.GenDecl{
 		Specs: []ast.Spec{spec},
@@ -489,6 +1376,10 @@ func newSelectConstDecl(field *Field) *ast.GenDecl {
 		return nil
 	}
 
+	if field.isStringSelect() {
+		return newStringSelectConstDecl(field)
+	}
+
 	specs := make([]ast.Spec, len(varNames))
 
 	valIdents := make([]*ast.Ident, len(varNames))
@@ -510,11 +1401,104 @@ func newSelectConstDecl(field *Field) *ast.GenDecl {
 		}
 	}
 
+	// Represents empty and unknown options
+	specs = append(specs, &ast.ValueSpec{
+		Names:  []*ast.Ident{ast.NewIdent(selectUnsetName(typeName))},
+		Type:   &ast.Ident{Name: typeName},
+		Values: []ast.Expr{&ast.UnaryExpr{Op: token.SUB, X: &ast.BasicLit{Kind: token.INT, Value: "1"}}},
+	})
+
 	decl := &ast.GenDecl{Specs: specs, Tok: token.CONST}
 
 	return decl
 }
 
+// Declares one constant per option whose value is the
+// option string itself
+func newStringSelectConstDecl(field *Field) *ast.GenDecl {
+	typeName := field.selectTypeName
+
+	specs := make([]ast.Spec, 0, len(field.selectVarNames)+1)
+	for i, varName := range field.selectVarNames {
+		specs = append(specs, &ast.ValueSpec{
+			Names:  []*ast.Ident{ast.NewIdent(varName)},
+			Type:   ast.NewIdent(typeName),
+			Values: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(field.selectOptions[i])}},
+		})
+	}
+
+	// Represents empty options
+	specs = append(specs, &ast.ValueSpec{
+		Names:  []*ast.Ident{ast.NewIdent(selectUnsetName(typeName))},
+		Type:   ast.NewIdent(typeName),
+		Values: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: `""`}},
+	})
+
+	return &ast.GenDecl{Specs: specs, Tok: token.CONST}
+}
+
+// Creates the methods of a select type
+func newSelectMethodDecls(field *Field) ([]*ast.FuncDecl, error) {
+	templates := iotaSelectMethodTemplates
+	if field.isStringSelect() {
+		templates = stringSelectMethodTemplates
+	}
+
+	decls := make([]*ast.FuncDecl, len(templates))
+	for i, template := range templates {
+		decl, err := adaptSelectTypeTemplate(template, field, template.Name.Name)
+		if err != nil {
+			return nil, err
+		}
+		decls[i] = decl
+	}
+
+	return decls, nil
+}
+
+// Creates the All<Type>() function that lists the
+// options of a select type
+func newSelectOptionsFuncDecl(field *Field) (*ast.FuncDecl, error) {
+	return adaptSelectTypeTemplate(selectOptionsFuncTemplate, field, "All"+field.selectTypeName)
+}
+
+func adaptSelectTypeTemplate(template *ast.FuncDecl, field *Field, funcName string) (*ast.FuncDecl, error) {
+	typeName := field.selectTypeName
+	typeIdent := ast.NewIdent(typeName)
+
+	decl := astcopy.FuncDecl(template)
+	err := adaptFuncTemplate(decl, typeName, funcName, "", "", "", typeIdent)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]ast.Expr, len(field.selectVarNames))
+	for i, varName := range field.selectVarNames {
+		options[i] = ast.NewIdent(varName)
+	}
+	ast.Inspect(decl, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CompositeLit:
+			n.Elts = options
+		case *ast.CaseClause:
+			if len(n.List) == 0 {
+				return true
+			}
+			if ident, ok := n.List[0].(*ast.Ident); ok && ident.Name == "Options" {
+				n.List = options
+			}
+		}
+		return true
+	})
+	replaceIdents(decl, map[string]string{
+		"Unset":      selectUnsetName(typeName),
+		"AllOptions": "All" + typeName,
+	})
+	replaceStringLits(decl, map[string]string{"StructName": typeName})
+
+	return decl, nil
+}
+
 func newGetOptionFunction(field *Field) *ast.FuncDecl {
 	typeName := field.selectTypeName
 	funcName := "Get" + typeName
@@ -541,40 +1525,14 @@ func newGetOptionFunction(field *Field) *ast.FuncDecl {
 		},
 		Body: &ast.BlockStmt{
 			List: []ast.Stmt{
-				&ast.AssignStmt{
-					Lhs: []ast.Expr{
-						ast.NewIdent("i"),
-						ast.NewIdent("ok"),
-					},
-					Tok: token.DEFINE,
-					Rhs: []ast.Expr{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
 						&ast.IndexExpr{
 							X:     ast.NewIdent(mapName),
 							Index: ast.NewIdent("option"),
 						},
 					},
 				},
-				&ast.IfStmt{
-					Cond: &ast.UnaryExpr{
-						Op: token.NOT,
-						X:  ast.NewIdent("ok"),
-					},
-					Body: &ast.BlockStmt{
-						List: []ast.Stmt{
-							&ast.ExprStmt{
-								X: &ast.CallExpr{
-									Fun:  ast.NewIdent("panic"),
-									Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: `"Unknown select value"`}},
-								},
-							},
-						},
-					},
-				},
-				&ast.ReturnStmt{
-					Results: []ast.Expr{
-						ast.NewIdent("i"),
-					},
-				},
 			},
 		},
 	}
@@ -626,10 +1584,9 @@ func newGetOptionValueFunction(field *Field) *ast.FuncDecl {
 					},
 					Body: &ast.BlockStmt{
 						List: []ast.Stmt{
-							&ast.ExprStmt{
-								X: &ast.CallExpr{
-									Fun:  ast.NewIdent("panic"),
-									Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: `"Unknown select value"`}},
+							&ast.ReturnStmt{
+								Results: []ast.Expr{
+									ast.NewIdent(selectUnsetName(typeName)),
 								},
 							},
 						},
@@ -698,6 +1655,10 @@ func newSelectMapDecl(field *Field, invertMapping bool) *ast.GenDecl {
 	return decl
 }
 
+func selectUnsetName(typeName string) string {
+	return typeName + "Unset"
+}
+
 func selectNameMapName(typeName string) string {
 	// zz to keep it at the bottom of intellisense
 	return fmt.Sprintf("zz%vSelectNameMap", typeName)
@@ -736,6 +1697,53 @@ func newProxyTypeConstraint(structNames []string) *ast.GenDecl {
 	return interfaceDecl
 }
 
+// Adds the CollectionId() method to the ProxyP interface
+// when the collections are looked up by id
+func newProxyPInterface(lookupById bool) *ast.GenDecl {
+	interfaceDecl := astcopy.GenDecl(proxyPInterfaceTemplate)
+	if !lookupById {
+		return interfaceDecl
+	}
+
+	interfaceSpec := interfaceDecl.Specs[0].(*ast.TypeSpec)
+	interfaceType := interfaceSpec.Type.(*ast.InterfaceType)
+	idMethod := astcopy.Field(interfaceType.Methods.List[len(interfaceType.Methods.List)-1])
+	idMethod.Names[0].Name = "CollectionId"
+	interfaceType.Methods.List = append(interfaceType.Methods.List, idMethod)
+
+	return interfaceDecl
+}
+
+// Rewrites the generic util functions to find and compare
+// the collections of the proxies by id instead of by name
+func newIdLookupDecl(template ast.Decl) ast.Decl {
+	decl := astcopy.Decl(template)
+	renames := map[string]string{
+		"CollectionName":      "CollectionId",
+		"collectionName":      "collectionId",
+		"proxyCollectionName": "proxyCollectionId",
+	}
+	ast.Inspect(decl, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if newName, ok := renames[n.Name]; ok {
+				n.Name = newName
+			}
+		case *ast.SelectorExpr:
+			// record.Collection().Name -> record.Collection().Id
+			call, ok := n.X.(*ast.CallExpr)
+			if !ok || n.Sel.Name != "Name" {
+				return true
+			}
+			if fun, ok := call.Fun.(*ast.SelectorExpr); ok && fun.Sel.Name == "Collection" {
+				n.Sel.Name = "Id"
+			}
+		}
+		return true
+	})
+	return decl
+}
+
 func newRelationMapDecl(collectionNames []string, relationMap map[string]map[string][]relationField) ast.Decl {
 	mapEntries := make([]ast.Expr, 0, len(relationMap))
 
@@ -806,6 +1814,286 @@ func relationFieldLits(relationFields []relationField) []ast.Expr {
 	return lits
 }
 
+// Creates the ChangedFields() method of a proxy from
+// the <Field>Changed() methods of its non-system fields.
+// Returns nil if the proxy has no such fields.
+func newChangedFieldsDecl(structName string, fields []*Field) *ast.FuncDecl {
+	decl := astcopy.FuncDecl(changedFieldsTemplate)
+	replaceIdentParts(decl, "StructName", structName)
+	decl.Doc = newDocComment("// Returns the fields whose values differ from the original record")
+
+	body := decl.Body.List
+	checkTemplate := body[1].(*ast.IfStmt)
+	checks := make([]ast.Stmt, 0, len(fields))
+	for _, field := range fields {
+		if field.systemFieldName != "" {
+			continue
+		}
+		name := getterName(field.fieldName)
+		check := astcopy.IfStmt(checkTemplate)
+		replaceIdents(check, map[string]string{
+			"FuncName":   name + "Changed",
+			"FieldConst": fieldNameConstName(structName, field),
+		})
+		checks = append(checks, check)
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+
+	decl.Body.List = append([]ast.Stmt{body[0]}, checks...)
+	decl.Body.List = append(decl.Body.List, body[2])
+
+	return decl
+}
+
+// Creates the fieldValues method that the field name constants
+// use to get the typed values of a field. Returns nil if the
+// proxy has no fields other than system fields.
+func newFieldValuesDecl(structName string, fields []*Field) (*ast.FuncDecl, error) {
+	decl := astcopy.FuncDecl(fieldValuesTemplate)
+	replaceIdents(decl, map[string]string{"StructName": structName})
+
+	switchStmt := decl.Body.List[0].(*ast.SwitchStmt)
+	caseTemplate := switchStmt.Body.List[0].(*ast.CaseClause)
+	cases := make([]ast.Stmt, 0, len(fields))
+	for _, field := range fields {
+		if field.systemFieldName != "" {
+			continue
+		}
+		getterFuncName, _, err := trackedValueGetter(field)
+		if err != nil {
+			return nil, err
+		}
+
+		c := astcopy.CaseClause(caseTemplate)
+		replaceIdents(c, map[string]string{
+			"FieldConst":       fieldNameConstName(structName, field),
+			"OriginalFuncName": "Original" + getterFuncName,
+			"FuncName":         getterFuncName,
+		})
+		cases = append(cases, c)
+	}
+	if len(cases) == 0 {
+		return nil, nil
+	}
+	switchStmt.Body.List = cases
+
+	decl.Doc = newDocComment("// Returns the original and the current value of a field")
+
+	return decl, nil
+}
+
+func newAuthGetterDecl(structName string) *ast.FuncDecl {
+	decl := astcopy.FuncDecl(authGetterTemplate)
+	replaceIdentParts(decl, "StructName", structName)
+	decl.Doc = newDocComment(fmt.Sprintf("// Returns the auth record of the request if it is a %v", structName))
+	return decl
+}
+
+func newCollectionFinderDecls(structName string) []ast.Decl {
+	decls := make([]ast.Decl, len(collectionFinderTemplates))
+	for i, template := range collectionFinderTemplates {
+		decl := astcopy.FuncDecl(template.(*ast.FuncDecl))
+		replaceIdentParts(decl, "StructName", structName)
+		decls[i] = decl
+	}
+
+	return decls
+}
+
+// Creates the typed expand path type of a proxy with one
+// method per relation field that continues the path
+func newExpandPathDecls(structName string, relFields []*Field, backRelFields []*Field) []ast.Decl {
+	expandName := map[string]string{"StructNameExpand": structName + "Expand"}
+	typeDecl := astcopy.GenDecl(expandTypeTemplate)
+	replaceIdents(typeDecl, expandName)
+
+	pathMethod := astcopy.FuncDecl(expandPathMethodTemplate)
+	replaceIdents(pathMethod, expandName)
+
+	decls := []ast.Decl{typeDecl, pathMethod}
+	for _, field := range relFields {
+		decl := newExpandRelationMethod(
+			structName,
+			getterName(field.fieldName),
+			baseType(field.fieldType).Name,
+			field.schemaName,
+		)
+		decls = append(decls, decl)
+	}
+	for _, field := range backRelFields {
+		collectionName := field.parser.collectionNames[field.structName]
+		decl := newExpandRelationMethod(
+			structName,
+			field.structName+"Via"+getterName(field.fieldName),
+			field.structName,
+			collectionName+"_via_"+field.schemaName,
+		)
+		decls = append(decls, decl)
+	}
+
+	return decls
+}
+
+func newExpandRelationMethod(structName, funcName, relStructName, key string) *ast.FuncDecl {
+	decl := astcopy.FuncDecl(expandRelationMethodTemplate)
+	// A single exact pass so that the inserted names are
+	// not mistaken for placeholders (e.g. AllFieldTypes)
+	replaceIdents(decl, map[string]string{
+		"StructNameExpand": structName + "Expand",
+		"FieldTypeExpand":  relStructName + "Expand",
+		"FuncName":         funcName,
+	})
+	replaceStringLits(decl, map[string]string{"key": key})
+	return decl
+}
+
+func newExpandRootDecl(structNames []string) *ast.GenDecl {
+	structFields := make([]*ast.Field, len(structNames))
+	for i, structName := range structNames {
+		structFields[i] = &ast.Field{
+			Names: []*ast.Ident{ast.NewIdent(structName)},
+			Type:  ast.NewIdent(structName + "Expand"),
+		}
+	}
+
+	decl := astcopy.GenDecl(expandRootTemplate)
+	adaptStructVarTemplate(decl, "Expand", structFields, nil)
+
+	return decl
+}
+
+// Creates the field name constants of a proxy. Returns nil
+// if the proxy has no fields. They share the PersonField prefix
+// instead of forming a PersonFields struct because a struct
+// could only be a variable that can be reassigned.
+func newFieldNamesDecl(structName string, fields []*Field) (*ast.GenDecl, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	decl := astcopy.GenDecl(fieldNamesTemplate)
+	specTemplate := decl.Specs[0].(*ast.ValueSpec)
+	specs := make([]ast.Spec, len(fields))
+	for i, field := range fields {
+		_, valueType, err := trackedValueGetter(field)
+		if err != nil {
+			return nil, err
+		}
+		spec := astcopy.ValueSpec(specTemplate)
+		replaceIdents(spec, map[string]string{
+			"FieldConst": fieldNameConstName(structName, field),
+			"StructName": structName,
+		})
+		spec.Type.(*ast.IndexListExpr).Indices[1] = valueType
+		replaceStringLits(spec, map[string]string{"key": recordKey(field)})
+		specs[i] = spec
+	}
+	decl.Specs = specs
+	decl.Doc = newDocComment(fmt.Sprintf("// The record field names of the %v proxy", structName))
+
+	return decl, nil
+}
+
+func newFilterBuilderDecl(structName string, fields []*Field) *ast.GenDecl {
+	structFields := make([]*ast.Field, 0, len(fields))
+	elts := make([]ast.Expr, 0, len(fields))
+	for _, field := range fields {
+		valueType := filterValueType(field)
+		if valueType == nil {
+			continue
+		}
+
+		name := getterName(field.fieldName)
+		key := &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(recordKey(field))}
+
+		var constructor ast.Expr
+		if field.selectTypeName != "" && !field.isStringSelect() {
+			constructor = &ast.CallExpr{
+				Fun:  ast.NewIdent("newSelectFilterField"),
+				Args: []ast.Expr{key, ast.NewIdent(selectIotaMapName(field.selectTypeName))},
+			}
+		} else {
+			constructor = &ast.CallExpr{
+				Fun:  &ast.IndexExpr{X: ast.NewIdent("newFilterField"), Index: valueType},
+				Args: []ast.Expr{key},
+			}
+		}
+		if _, ok := field.fieldType.(*ast.ArrayType); ok {
+			constructor = &ast.CallExpr{
+				Fun: &ast.SelectorExpr{X: constructor, Sel: ast.NewIdent("multi")},
+			}
+		}
+
+		structFields = append(structFields, &ast.Field{
+			Names: []*ast.Ident{ast.NewIdent(name)},
+			Type:  &ast.IndexExpr{X: ast.NewIdent("FilterField"), Index: astcopy.Expr(valueType)},
+		})
+		elts = append(elts, &ast.KeyValueExpr{Key: ast.NewIdent(name), Value: constructor})
+	}
+
+	decl := astcopy.GenDecl(filterBuilderTemplate)
+	decl.Doc = newDocComment(fmt.Sprintf("// The typed filter builder of the %v proxy", structName))
+	adaptStructVarTemplate(decl, structName+"Filter", structFields, elts)
+
+	return decl
+}
+
+// Replaces the name, the struct type fields and the
+// literal elements of a template like
+//
+//	var StructNameFields = struct{}{}
+func adaptStructVarTemplate(decl *ast.GenDecl, varName string, structFields []*ast.Field, elts []ast.Expr) {
+	spec := decl.Specs[0].(*ast.ValueSpec)
+	spec.Names = []*ast.Ident{ast.NewIdent(varName)}
+	lit := spec.Values[0].(*ast.CompositeLit)
+	lit.Type.(*ast.StructType).Fields.List = structFields
+	lit.Elts = elts
+}
+
+// Returns the type that filter values of the field have or
+// nil if the field can not be filtered with comparisons
+func filterValueType(field *Field) ast.Expr {
+	if field.selectTypeName != "" {
+		return ast.NewIdent(field.selectTypeName)
+	}
+
+	if field.isRelation() {
+		// Relations are filtered by record id
+		return ast.NewIdent("string")
+	}
+
+	fieldType := field.fieldType
+	if arrayType, ok := fieldType.(*ast.ArrayType); ok {
+		fieldType = arrayType.Elt
+	}
+
+	typeName, err := nodeString(fieldType)
+	if err != nil || typeName == "types.GeoPoint" {
+		return nil
+	}
+
+	return astcopy.Expr(fieldType)
+}
+
+// Returns the name of the field in the record
+func recordKey(field *Field) string {
+	if field.systemFieldName != "" {
+		return field.systemFieldName
+	}
+	return field.schemaName
+}
+
+// Returns the name of the field name constant, e.g. PersonFieldHealth
+func fieldNameConstName(structName string, field *Field) string {
+	return structName + "Field" + getterName(field.fieldName)
+}
+
+func newDocComment(text string) *ast.CommentGroup {
+	return &ast.CommentGroup{List: []*ast.Comment{{Text: text}}}
+}
+
 func newEventTypeAliasDecl(template *ast.GenDecl, structName string) *ast.GenDecl {
 	alias := astcopy.GenDecl(template)
 
@@ -832,10 +2120,7 @@ func newHookField(template *ast.Field, structName string) *ast.Field {
 
 	name := field.Names[0]
 	name.Name = "On" + structName + name.Name
-
-	genericArg := field.Type.(*ast.StarExpr).X.(*ast.IndexExpr).Index.(*ast.StarExpr)
-	genericTypeName := genericArg.X.(*ast.Ident)
-	genericTypeName.Name = structName + genericTypeName.Name
+	prefixAliasNames(field.Type, structName)
 
 	return field
 }
@@ -853,14 +2138,26 @@ func newHookConstructorArgument(template *ast.KeyValueExpr, structName string) *
 
 	key := expr.Key.(*ast.Ident)
 	key.Name = "On" + structName + key.Name
-
-	genericArg := expr.Value.(*ast.UnaryExpr).X.(*ast.CompositeLit).Type.(*ast.IndexExpr).Index.(*ast.StarExpr)
-	genericTypeName := genericArg.X.(*ast.Ident)
-	genericTypeName.Name = structName + genericTypeName.Name
+	prefixAliasNames(expr.Value, structName)
 
 	return expr
 }
 
+// Prefixes the names of the event and hook type aliases in
+// a hook template with the struct name. Package qualified
+// names like hook.Hook are kept.
+func prefixAliasNames(node ast.Node, structName string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.Ident:
+			n.Name = structName + n.Name
+		}
+		return true
+	})
+}
+
 func newHookRegistrationFuncDecl() *ast.FuncDecl {
 	decl := astcopy.FuncDecl(proxyHookRegistrationTemplate)
 	decl.Body.List = []ast.Stmt{}
@@ -875,8 +2172,18 @@ func newHookRegistrationCallExpr(template *ast.ExprStmt, structName, collectionN
 	recordHookGetter := args[0].(*ast.CallExpr)
 	recordHookGetter.Args[0].(*ast.BasicLit).Value = "\"" + collectionName + "\""
 
-	proxyHookSelector := args[1].(*ast.SelectorExpr)
-	proxyHookSelector.Sel.Name = "On" + structName + proxyHookSelector.Sel.Name
+	// Either pHooks.Name or &pHooks.Name.Hook
+	ast.Inspect(args[1], func(n ast.Node) bool {
+		selector, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := selector.X.(*ast.Ident); ok && ident.Name == "pHooks" {
+			selector.Sel.Name = "On" + structName + selector.Sel.Name
+			return false
+		}
+		return true
+	})
 
 	return expr
 }
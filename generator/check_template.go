@@ -0,0 +1,204 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// Compares the template with the collections of a PocketBase schema.
+// Reports added, removed and retyped collections and fields, changed
+// select options and changed relation targets. Returns one message
+// per difference or nil when the template matches the schema.
+func CheckTemplate(collections []*core.Collection, templateParser *Parser) []string {
+	p := templateParser
+	collectionIds := make(map[string]*core.Collection, len(collections))
+	for _, c := range collections {
+		collectionIds[c.Id] = c
+	}
+	matches := matchCollections(p, collections)
+	matched := make(map[*core.Collection]bool, len(matches))
+	for _, c := range matches {
+		matched[c] = true
+	}
+
+	var drift []string
+	for _, collection := range collections {
+		if !matched[collection] {
+			drift = append(drift, fmt.Sprintf("collection %q was added to the schema", collection.Name))
+		}
+	}
+
+	for _, s := range p.structSpecs {
+		structName := s.Name.Name
+		collectionName := p.collectionNames[structName]
+		if collectionName == "" {
+			continue
+		}
+		collection, ok := matches[structName]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("collection %q (%v) was removed from the schema", collectionName, structName))
+			continue
+		}
+		if collection.Name != collectionName {
+			drift = append(drift, fmt.Sprintf("collection %q (%v) was renamed to %q", collectionName, structName, collection.Name))
+		}
+		for _, msg := range checkCollection(collection, p.structFields[structName], p.collectionTypes[structName], collectionIds) {
+			drift = append(drift, fmt.Sprintf("collection %q: %v", collection.Name, msg))
+		}
+	}
+
+	return drift
+}
+
+// Finds the collection of every template struct. Structs with a
+// '// collection-id:' comment are matched by id and the others
+// by their collection name. Unmatched structs are left out.
+func matchCollections(p *Parser, collections []*core.Collection) map[string]*core.Collection {
+	matches := make(map[string]*core.Collection, len(collections))
+	for _, s := range p.structSpecs {
+		structName := s.Name.Name
+		collectionName := p.collectionNames[structName]
+		if collectionName == "" {
+			continue
+		}
+		collectionId := p.collectionIds[structName]
+		for _, c := range collections {
+			if collectionId != "" && c.Id == collectionId {
+				matches[structName] = c
+				break
+			}
+			if collectionId == "" && c.Name == collectionName {
+				matches[structName] = c
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// Finds the schema field of a template field by its
+// '// field-id:' comment or otherwise by its name
+func matchField(field *Field, collection *core.Collection) core.Field {
+	if field.fieldId != "" {
+		return collection.Fields.GetById(field.fieldId)
+	}
+	return collection.Fields.GetByName(recordKey(field))
+}
+
+func checkCollection(
+	collection *core.Collection,
+	fields []*Field,
+	collectionType string,
+	collectionIds map[string]*core.Collection,
+) []string {
+	var drift []string
+
+	if collectionType == "" {
+		collectionType = core.CollectionTypeBase
+	}
+	if collection.Type != collectionType {
+		drift = append(drift, fmt.Sprintf("is of type %v instead of %v", collection.Type, collectionType))
+	}
+
+	matched := make([]core.Field, 0, len(fields))
+	for _, field := range fields {
+		if schemaField := matchField(field, collection); schemaField != nil {
+			matched = append(matched, schemaField)
+		}
+	}
+	for _, schemaField := range collection.Fields {
+		if !slices.Contains(matched, schemaField) {
+			drift = append(drift, fmt.Sprintf("field %q was added to the schema", schemaField.GetName()))
+		}
+	}
+
+	for _, field := range fields {
+		key := recordKey(field)
+		schemaField := matchField(field, collection)
+		if schemaField == nil {
+			drift = append(drift, fmt.Sprintf("field %q (%v) was removed from the schema", key, field.fieldName))
+			continue
+		}
+		if schemaField.GetName() != key {
+			drift = append(drift, fmt.Sprintf("field %q (%v) was renamed to %q", key, field.fieldName, schemaField.GetName()))
+		}
+		if msg := checkField(field, schemaField, collectionIds); msg != "" {
+			drift = append(drift, fmt.Sprintf("field %q: %v", schemaField.GetName(), msg))
+		}
+	}
+
+	return drift
+}
+
+// Mirrors the checks of the generated VerifySchema
+func checkField(field *Field, schemaField core.Field, collectionIds map[string]*core.Collection) string {
+	if msg := checkFieldType(field, schemaField, collectionIds); msg != "" {
+		return msg
+	}
+	return checkSelectOptions(field, schemaField)
+}
+
+// Checks the type, the number of values and the relation
+// target of a field. These changes require a new field type.
+func checkFieldType(field *Field, schemaField core.Field, collectionIds map[string]*core.Collection) string {
+	types := schemaFieldTypes(field)
+	if !slices.Contains(types, schemaField.Type()) {
+		return fmt.Sprintf("is of type %v instead of %v", schemaField.Type(), strings.Join(types, " or "))
+	}
+
+	multiple := isMultiValueField(field)
+	if multi, ok := schemaField.(core.MultiValuer); ok && multi.IsMultiple() != multiple {
+		if multiple {
+			return "only allows a single value"
+		}
+		return "allows multiple values"
+	}
+
+	if relationField, ok := schemaField.(*core.RelationField); ok && field.isRelation() {
+		relatedStruct := baseType(field.fieldType).Name
+		expected := field.parser.collectionNames[relatedStruct]
+		related, ok := collectionIds[relationField.CollectionId]
+
+		changed := false
+		if expectedId := field.parser.collectionIds[relatedStruct]; expectedId != "" {
+			// A renamed target collection keeps its id
+			changed = relationField.CollectionId != expectedId
+		} else {
+			changed = ok && expected != "" && related.Name != expected
+		}
+		if changed {
+			relatedName := relationField.CollectionId
+			if ok {
+				relatedName = related.Name
+			}
+			return fmt.Sprintf("relates to collection %q instead of %q", relatedName, expected)
+		}
+	}
+
+	return ""
+}
+
+func checkSelectOptions(field *Field, schemaField core.Field) string {
+	selectField, ok := schemaField.(*core.SelectField)
+	if !ok || field.selectTypeName == "" {
+		return ""
+	}
+
+	// Fields that reuse an identical select type have no options of their own
+	typeOptions := field.parser.selectTypeToOptions[field.selectTypeName]
+	options := slices.Clone(selectField.Values)
+	expected := slices.Clone(typeOptions)
+	// The options of iota select types are stored by their index
+	if field.isStringSelect() {
+		slices.Sort(options)
+		slices.Sort(expected)
+	}
+	if !slices.Equal(options, expected) {
+		return fmt.Sprintf("has the select options %v instead of %v", selectField.Values, typeOptions)
+	}
+
+	return ""
+}
@@ -0,0 +1,107 @@
+package generator_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/nedieyassin/pocketbase-gogen/generator"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+func TestCheckTemplate(t *testing.T) {
+	collections, err := QuerySchema("./db_test/test_pb_data", false)
+	if err != nil {
+		t.Fatalf("Error during schema query: %v", err)
+	}
+
+	template, err := Template(collections, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during template generation: %v", err)
+	}
+
+	p, err := NewTemplateParser(template)
+	if err != nil {
+		t.Fatalf("Error during template parsing: %v", err)
+	}
+
+	if drift := CheckTemplate(collections, p); drift != nil {
+		t.Fatalf("a freshly generated template reported drift: %v", drift)
+	}
+
+	drifted := strings.NewReplacer(
+		"\t// collection-type: auth\n", "",
+		"\t// field-id: bool2232122523\n\tboolValue bool\n", "",
+		"intergerNumber int", "intergerNumber bool",
+		"\turl string\n", "\turl string\n\twebsite string\n",
+		"(optionA, optionB, optionC)", "(optionA, optionC, optionB)",
+		"oneFile string", "oneFile []string",
+		"multiRelation []*AllFieldTypes", "multiRelation []*AuthCollection",
+		// Without the id the struct is matched by its collection name
+		"collection-name: with_reserved_go_names\n\t// collection-id: pbc_765345972", "collection-name: with_reserved_names",
+	).Replace(string(template))
+
+	p, err = NewTemplateParser([]byte(drifted))
+	if err != nil {
+		t.Fatalf("Error during template parsing: %v", err)
+	}
+
+	for _, c := range collections {
+		switch c.Name {
+		case "auth_collection":
+			c.Name = "members"
+		case "all_field_types":
+			c.Fields.GetByName("email").SetName("mail")
+		}
+	}
+
+	expectedDrift := []string{
+		`collection "with_reserved_go_names" was added to the schema`,
+		`collection "auth_collection" (AuthCollection) was renamed to "members"`,
+		`collection "members": is of type auth instead of base`,
+		`collection "all_field_types": field "boolValue" was added to the schema`,
+		`collection "all_field_types": field "oneFile": only allows a single value`,
+		`collection "all_field_types": field "intergerNumber": is of type number instead of bool`,
+		`collection "all_field_types": field "email" (email) was renamed to "mail"`,
+		`collection "all_field_types": field "website" (website) was removed from the schema`,
+		`collection "all_field_types": field "singleSelect": has the select options [optionA optionB optionC] instead of [optionA optionC optionB]`,
+		`collection "all_field_types": field "multiRelation": relates to collection "all_field_types" instead of "auth_collection"`,
+		`collection "with_reserved_names" (WithReservedGoNames) was removed from the schema`,
+	}
+
+	drift := CheckTemplate(collections, p)
+	if !slices.Equal(drift, expectedDrift) {
+		t.Fatalf("the reported drift did not match the expected drift:\n%v", strings.Join(drift, "\n"))
+	}
+}
+
+func TestCheckTemplateReusedSelectType(t *testing.T) {
+	collections, err := QuerySchema("./db_test/test_pb_data", false)
+	if err != nil {
+		t.Fatalf("Error during schema query: %v", err)
+	}
+
+	template, err := Template(collections, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during template generation: %v", err)
+	}
+
+	// The otherSelect field reuses the select type of the singleSelect field
+	singleSelect := "\t// select: AllFieldTypesSingleSelectOptions(optionA, optionB, optionC)[AllFieldTypesSingleSelectoptionA, AllFieldTypesSingleSelectoptionB, AllFieldTypesSingleSelectoptionC]\n"
+	withReusedSelect := strings.Replace(string(template), singleSelect, singleSelect+"\totherSelect int\n"+singleSelect, 1)
+
+	p, err := NewTemplateParser([]byte(withReusedSelect))
+	if err != nil {
+		t.Fatalf("Error during template parsing: %v", err)
+	}
+
+	for _, c := range collections {
+		if c.Name == "all_field_types" {
+			c.Fields.Add(&core.SelectField{Name: "otherSelect", Values: []string{"optionA", "optionB", "optionC"}, MaxSelect: 1})
+		}
+	}
+
+	if drift := CheckTemplate(collections, p); drift != nil {
+		t.Fatalf("the reused select type reported drift: %v", drift)
+	}
+}
@@ -0,0 +1,465 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-toolsmith/astcopy"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+var (
+	requiredComment    = "// required:"
+	minComment         = "// min:"
+	maxComment         = "// max:"
+	patternComment     = "// pattern:"
+	maxSelectComment   = "// max-select:"
+	maxSizeComment     = "// max-size:"
+	onlyDomainsComment = "// only-domains:"
+)
+
+// The field constraints of the PB schema as they are
+// recorded by the constraint comments of a template field.
+// Used to generate the Validate() method of a proxy.
+type fieldConstraints struct {
+	required  bool
+	min, max  *float64
+	pattern   string
+	maxSelect int
+	maxSize   int64
+	domains   []string
+
+	// "json", "email" or "url" for string fields where the
+	// PB field type changes how a constraint is checked.
+	// Written as a qualifier like 'json(1024)' or 'email(a.com)'
+	// or as the value of a '// required: json' comment.
+	kind string
+}
+
+// Matches qualified comment values of the form 'kind(value)'.
+// Only the max-size and only-domains directives can have them.
+var qualifiedValue = regexp.MustCompile(`^(json|email|url)\((.*)\)$`)
+
+// Parses all constraint comments of a template field.
+// Returns nil if the field does not have any.
+func (p *Parser) parseConstraintComments(field *ast.Field) (*fieldConstraints, error) {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return nil, nil
+	}
+
+	typeName, err := nodeString(field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	var constraints *fieldConstraints
+	for _, c := range field.Doc.List {
+		directive, value, ok := constraintDirective(c.Text)
+		if !ok {
+			continue
+		}
+		pos := p.Fset.Position(c.Slash)
+
+		if len(field.Names) > 1 {
+			errMsg := fmt.Sprintf("The %v comment can only be used on fields with one identifier. Found %v.", directive, len(field.Names))
+			return nil, p.createError(errMsg, pos, nil)
+		}
+
+		if constraints == nil {
+			constraints = &fieldConstraints{}
+		}
+		if directive == maxSizeComment || directive == onlyDomainsComment {
+			if m := qualifiedValue.FindStringSubmatch(value); m != nil {
+				constraints.kind = m[1]
+				value = strings.TrimSpace(m[2])
+			}
+		}
+
+		err := constraints.set(directive, value, typeName)
+		if err != nil {
+			return nil, p.createError(err.Error(), pos, nil)
+		}
+	}
+
+	return constraints, nil
+}
+
+// Returns the constraint directive of the comment and
+// the value that follows it.
+func constraintDirective(comment string) (string, string, bool) {
+	directives := []string{
+		requiredComment,
+		minComment,
+		maxComment,
+		patternComment,
+		maxSelectComment,
+		maxSizeComment,
+		onlyDomainsComment,
+	}
+	for _, d := range directives {
+		if len(comment) >= len(d) && comment[:len(d)] == d {
+			return d, strings.TrimSpace(comment[len(d):]), true
+		}
+	}
+	return "", "", false
+}
+
+func (c *fieldConstraints) set(directive, value, typeName string) error {
+	isString := typeName == "string"
+	isNumber := typeName == "float64" || typeName == "int"
+	isMulti := strings.HasPrefix(typeName, "[]")
+
+	switch directive {
+	case requiredComment:
+		switch value {
+		case "":
+		case "json":
+			c.kind = "json"
+		default:
+			return fmt.Errorf("Malformed %v comment. Example usage: %v", directive, directive)
+		}
+		c.required = true
+	case minComment, maxComment:
+		if !isString && !isNumber {
+			return fmt.Errorf("Cannot have %v comment on field of type other than string, int or float64", directive)
+		}
+		limit, err := strconv.ParseFloat(value, 64)
+		if err != nil || (isString && limit != math.Trunc(limit)) {
+			return fmt.Errorf("Malformed %v comment. Example usage: %v 10", directive, directive)
+		}
+		if directive == minComment {
+			c.min = &limit
+		} else {
+			c.max = &limit
+		}
+	case patternComment:
+		if !isString {
+			return fmt.Errorf("Cannot have %v comment on field of type other than string", directive)
+		}
+		if _, err := regexp.Compile(value); err != nil || value == "" {
+			return fmt.Errorf("Malformed %v comment. Example usage: %v ^[a-z]+$", directive, directive)
+		}
+		c.pattern = value
+	case maxSelectComment:
+		if !isMulti {
+			return fmt.Errorf("Cannot have %v comment on a field that is not a slice", directive)
+		}
+		maxSelect, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("Malformed %v comment. Example usage: %v 3", directive, directive)
+		}
+		c.maxSelect = maxSelect
+	case maxSizeComment:
+		if !isString && typeName != "[]string" {
+			return fmt.Errorf("Cannot have %v comment on field of type other than string or []string", directive)
+		}
+		maxSize, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Malformed %v comment. Example usage: %v 5242880", directive, directive)
+		}
+		c.maxSize = maxSize
+	case onlyDomainsComment:
+		if !isString || (c.kind != "email" && c.kind != "url") {
+			return fmt.Errorf("Malformed %v comment. Example usage: %v email(example.com, example.org)", directive, directive)
+		}
+		for _, d := range strings.Split(value, ",") {
+			if d = strings.TrimSpace(d); d != "" {
+				c.domains = append(c.domains, d)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Creates the Validate() method of a proxy that checks
+// the constraints of all its fields. The returned error
+// is a validation.Errors keyed by the schema field names.
+// Returns nil if none of the fields has constraints.
+func newValidateDecl(structName string, fields []*Field) (*ast.FuncDecl, error) {
+	checks := make([]ast.Stmt, 0, 8)
+	for _, f := range fields {
+		if f.constraints == nil {
+			continue
+		}
+		fieldChecks, err := newConstraintChecks(f)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, fieldChecks...)
+	}
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	decl := astcopy.FuncDecl(validateTemplate)
+	err := adaptFuncTemplate(decl, structName, "Validate", "", "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body := decl.Body.List
+	stmts := make([]ast.Stmt, 0, len(checks)+2)
+	stmts = append(stmts, body[0])
+	stmts = append(stmts, checks...)
+	stmts = append(stmts, body[1])
+	decl.Body.List = stmts
+
+	return decl, nil
+}
+
+// Creates the package level variables with the compiled
+// patterns of the fields so that Validate() does not compile
+// them on every call
+func newPatternDecls(fields []*Field) []ast.Decl {
+	decls := make([]ast.Decl, 0)
+	for _, field := range fields {
+		if field.constraints == nil || field.constraints.pattern == "" {
+			continue
+		}
+		decl := astcopy.GenDecl(patternTemplate)
+		spec := decl.Specs[0].(*ast.ValueSpec)
+		spec.Names[0].Name = patternVarName(field)
+		spec.Values[0].(*ast.CallExpr).Args[0].(*ast.BasicLit).Value = stringLiteral(field.constraints.pattern)
+		decls = append(decls, decl)
+	}
+	return decls
+}
+
+func patternVarName(field *Field) string {
+	return "zz" + field.structName + getterName(field.fieldName) + "Pattern"
+}
+
+// Creates the checks of a single field in the order
+// that PocketBase itself checks them.
+func newConstraintChecks(field *Field) ([]ast.Stmt, error) {
+	c := field.constraints
+	value, isEmpty := constraintValue(field)
+
+	checks := make([][3]string, 0, 4)
+	addCheck := func(violated, constraintError string) {
+		checks = append(checks, [3]string{value, violated, constraintError})
+	}
+
+	typeName, _ := nodeString(field.fieldType)
+	isText := typeName == "string" && c.kind == "" && !field.isFile
+
+	if c.required {
+		addCheck(isEmpty, "validation.ErrRequired")
+	}
+	if c.min != nil {
+		limit := formatLimit(*c.min)
+		if isText {
+			addCheck(
+				"value != \"\" && utf8.RuneCountInString(value) < "+limit,
+				newErrorExpr("validation_min_text_constraint", "Must be at least {{.min}} character(s)", "min", limit),
+			)
+		} else {
+			msg := fmt.Sprintf("Must be larger than %f", *c.min)
+			addCheck("value != 0 && value < "+limit, newErrorExpr("validation_min_number_constraint", msg, "", ""))
+		}
+	}
+	if c.max != nil {
+		limit := formatLimit(*c.max)
+		if isText {
+			addCheck(
+				"utf8.RuneCountInString(value) > "+limit,
+				newErrorExpr("validation_max_text_constraint", "Must be no more than {{.max}} character(s)", "max", limit),
+			)
+		} else {
+			msg := fmt.Sprintf("Must be less than %f", *c.max)
+			addCheck("value != 0 && value > "+limit, newErrorExpr("validation_max_number_constraint", msg, "", ""))
+		}
+	}
+	if c.pattern != "" {
+		addCheck(
+			fmt.Sprintf("value != \"\" && !%v.MatchString(value)", patternVarName(field)),
+			newErrorExpr("validation_invalid_format", "Invalid value format", "", ""),
+		)
+	}
+	if c.maxSelect > 0 {
+		maxSelect := strconv.Itoa(c.maxSelect)
+		if field.isFile {
+			addCheck(
+				fmt.Sprintf("validation.Length(0, %v).Validate(value) != nil", maxSelect),
+				newErrorExpr("validation_too_many_files", "The maximum allowed files is {{.maxSelect}}", "maxSelect", maxSelect),
+			)
+		} else {
+			addCheck(
+				"len(value) > "+maxSelect,
+				newErrorExpr("validation_too_many_values", "Select no more than {{.maxSelect}}", "maxSelect", maxSelect),
+			)
+		}
+	}
+	if c.maxSize > 0 && !field.isFile {
+		maxSize := strconv.FormatInt(c.maxSize, 10)
+		if c.kind == "json" {
+			addCheck(
+				"len(value) > "+maxSize,
+				newErrorExpr("validation_json_size_limit", "The maximum allowed JSON size is {{.maxSize}} bytes", "maxSize", maxSize),
+			)
+		} else {
+			addCheck(
+				"len(value) > "+maxSize,
+				newErrorExpr("validation_content_size_limit", "The maximum allowed content size is {{.maxSize}} bytes", "maxSize", maxSize),
+			)
+		}
+	}
+
+	stmts := make([]ast.Stmt, 0, len(checks)+2)
+	for _, check := range checks {
+		stmt, err := newCheckStmt(field, constraintCheckTemplate, map[string][]string{
+			"Value":           {check[0]},
+			"Violated":        {fmt.Sprintf("errs[%q] == nil && %v", field.schemaName, check[1])},
+			"ConstraintError": {check[2]},
+		})
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	var template *ast.FuncDecl
+	replacements := make(map[string][]string, 1)
+	switch {
+	case c.maxSize > 0 && field.isFile:
+		template = fileSizeCheckTemplate
+		replacements["MaxSize"] = []string{strconv.FormatInt(c.maxSize, 10)}
+	case len(c.domains) > 0 && c.kind == "email":
+		template = emailDomainCheckTemplate
+		replacements["Domains"] = quoteAll(c.domains)
+	case len(c.domains) > 0 && c.kind == "url":
+		template = urlDomainCheckTemplate
+		replacements["Domains"] = quoteAll(c.domains)
+	}
+	if template != nil {
+		stmt, err := newCheckStmt(field, template, replacements)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	return stmts, nil
+}
+
+// Returns the expression that reads the field value for
+// the constraint checks and the condition that reports
+// an empty value the same way PocketBase does.
+func constraintValue(field *Field) (string, string) {
+	key := strconv.Quote(field.schemaName)
+	typeName, _ := nodeString(field.fieldType)
+
+	switch {
+	case field.isFile:
+		return fmt.Sprintf("p.GetRaw(%v)", key), "validation.Required.Validate(value) != nil"
+	case relationType(field.fieldType) == multiRel:
+		return fmt.Sprintf("p.GetStringSlice(%v)", key), "len(value) == 0"
+	case field.selectTypeName != "", strings.HasPrefix(typeName, "*"):
+		return fmt.Sprintf("p.GetString(%v)", key), "value == \"\""
+	case field.constraints.kind == "json":
+		return fmt.Sprintf("strings.TrimSpace(p.GetString(%v))", key), "(value == \"\" || value == \"null\" || value == `\"\"` || value == \"[]\" || value == \"{}\")"
+	}
+
+	switch typeName {
+	case "string":
+		return fmt.Sprintf("p.GetString(%v)", key), "value == \"\""
+	case "float64", "int":
+		return fmt.Sprintf("p.GetFloat(%v)", key), "value == 0"
+	case "bool":
+		return fmt.Sprintf("p.GetBool(%v)", key), "!value"
+	case "types.DateTime":
+		return fmt.Sprintf("p.GetDateTime(%v)", key), "value.IsZero()"
+	case "types.GeoPoint":
+		return fmt.Sprintf("p.GetGeoPoint(%v)", key), "value.Lon == 0 && value.Lat == 0"
+	}
+	return fmt.Sprintf("p.GetRaw(%v)", key), "validation.Required.Validate(value) != nil"
+}
+
+// Copies the single statement of a check template and replaces
+// the placeholder identifiers with the given expressions.
+// A placeholder in a list (like a case clause) can be replaced
+// by multiple expressions.
+func newCheckStmt(field *Field, template *ast.FuncDecl, replacements map[string][]string) (ast.Stmt, error) {
+	decl := astcopy.FuncDecl(template)
+	err := adaptFuncTemplate(decl, field.structName, "_", "", field.fieldName, field.schemaName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := make(map[string][]ast.Expr, len(replacements))
+	for ident, exprStrs := range replacements {
+		for _, exprStr := range exprStrs {
+			expr, err := parser.ParseExpr(exprStr)
+			if err != nil {
+				return nil, err
+			}
+			exprs[ident] = append(exprs[ident], expr)
+		}
+	}
+
+	astutil.Apply(decl.Body, func(c *astutil.Cursor) bool {
+		ident, ok := c.Node().(*ast.Ident)
+		if !ok {
+			return true
+		}
+		replacement, ok := exprs[ident.Name]
+		if !ok {
+			return true
+		}
+		for i := len(replacement) - 1; i > 0; i-- {
+			c.InsertAfter(astcopy.Expr(replacement[i]))
+		}
+		c.Replace(astcopy.Expr(replacement[0]))
+		return true
+	}, nil)
+
+	return decl.Body.List[0], nil
+}
+
+func newErrorExpr(code, msg, param, paramValue string) string {
+	expr := fmt.Sprintf("validation.NewError(%q, %q)", code, msg)
+	if param != "" {
+		expr += fmt.Sprintf(".SetParams(map[string]any{%q: %v})", param, paramValue)
+	}
+	return expr
+}
+
+func formatLimit(limit float64) string {
+	return strconv.FormatFloat(limit, 'f', -1, 64)
+}
+
+func stringLiteral(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+func quoteAll(strs []string) []string {
+	quoted := make([]string, len(strs))
+	for i, s := range strs {
+		quoted[i] = strconv.Quote(s)
+	}
+	return quoted
+}
+
+// The imports that the generated Validate() methods need
+// and that goimports can not reliably resolve by itself
+func validationImports() []ast.Spec {
+	return []ast.Spec{
+		&ast.ImportSpec{
+			Name: ast.NewIdent("validation"),
+			Path: &ast.BasicLit{Kind: token.STRING, Value: `"github.com/go-ozzo/ozzo-validation/v4"`},
+		},
+		&ast.ImportSpec{
+			Path: &ast.BasicLit{Kind: token.STRING, Value: `"github.com/pocketbase/pocketbase/core/validators"`},
+		},
+	}
+}
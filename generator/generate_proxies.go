@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/iancoleman/strcase"
+	"github.com/pocketbase/pocketbase/core"
 	"github.com/snonky/astpos/astpos"
 	"golang.org/x/tools/go/ast/astutil"
 )
@@ -44,7 +45,7 @@ func Generate(templateParser *Parser, savePath, packageName string) ([]byte, err
 		return nil, err
 	}
 
-	err = checkPbShadows(sourceCode)
+	err = checkPbShadows(sourceCode, templateParser.allowedShadows())
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +53,19 @@ func Generate(templateParser *Parser, savePath, packageName string) ([]byte, err
 	return sourceCode, nil
 }
 
-func checkPbShadows(sourceCode []byte) error {
+// Returns the core.Record names that the proxy of each
+// struct is allowed to shadow
+func (p *Parser) allowedShadows() map[string]map[string]any {
+	allowed := make(map[string]map[string]any)
+	for structName, cType := range p.collectionTypes {
+		if cType == core.CollectionTypeAuth {
+			allowed[structName] = authMethodNames()
+		}
+	}
+	return allowed
+}
+
+func checkPbShadows(sourceCode []byte, allowedShadows map[string]map[string]any) error {
 	fset := token.NewFileSet()
 	f, err := parser.ParseFile(fset, "shadowcheck.go", sourceCode, parser.SkipObjectResolution)
 	if err != nil {
@@ -82,7 +95,11 @@ func checkPbShadows(sourceCode []byte) error {
 			continue
 		}
 		_, shadows := pbInfo.shadowsRecord(proxyType)
-		allShadows = append(allShadows, shadows...)
+		for _, shadow := range shadows {
+			if _, ok := allowedShadows[name][shadow]; !ok {
+				allShadows = append(allShadows, shadow)
+			}
+		}
 	}
 
 	if len(allShadows) > 0 {
@@ -109,12 +126,17 @@ func proxiesFromGoTemplate(p *Parser) ([]ast.Decl, error) {
 	}
 
 	decls := make([]ast.Decl, 0, 25)
+	var extraImports []ast.Spec
 	for _, s := range p.structSpecs {
 
 		structName := s.Name.Name
 		fields := p.structFields[structName]
 
-		decls = append(decls, createSelectTypes(fields)...)
+		selectTypes, err := createSelectTypes(fields)
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, selectTypes...)
 		decls = append(decls, newProxyDecl(structName, s.Doc))
 
 		methods := proxyMethods[structName]
@@ -124,6 +146,20 @@ func proxiesFromGoTemplate(p *Parser) ([]ast.Decl, error) {
 		if nameGetter != nil {
 			decls = append(decls, nameGetter)
 		}
+		if collectionId := p.collectionIds[structName]; nameGetter != nil && collectionId != "" {
+			idGetter, _ := newCollectionNameGetter("CollectionId", structName, collectionId)
+			decls = append(decls, idGetter)
+		}
+
+		if p.collectionTypes[structName] == core.CollectionTypeAuth {
+			authMethods, err := newAuthMethodDecls(structName)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range authMethods {
+				decls = append(decls, m)
+			}
+		}
 
 		getters, err := createFuncs(fields, newGetterDecl)
 		if err != nil {
@@ -133,17 +169,96 @@ func proxiesFromGoTemplate(p *Parser) ([]ast.Decl, error) {
 		if err != nil {
 			return nil, err
 		}
+		variants, err := createFuncLists(fields, newAccessorVariantDecls)
+		if err != nil {
+			return nil, err
+		}
 		for i, getter := range getters {
 			if getter == nil {
 				continue
 			}
-			decls = append(decls, getter, setters[i])
+			decls = append(decls, getter)
+			if setters[i] != nil {
+				decls = append(decls, setters[i])
+			}
+			for _, variant := range variants[i] {
+				decls = append(decls, variant)
+			}
+		}
+
+		for _, relField := range p.backRelationFields(structName) {
+			backRelations, err := newBackRelationDecls(structName, relField)
+			if err != nil {
+				return nil, err
+			}
+			for _, decl := range backRelations {
+				decls = append(decls, decl)
+			}
+		}
+
+		fieldNames, err := newFieldNamesDecl(structName, fields)
+		if err != nil {
+			return nil, err
 		}
+		if fieldNames != nil {
+			decls = append(decls, fieldNames)
+		}
+
+		if p.collectionTypes[structName] == core.CollectionTypeView {
+			continue
+		}
+		fieldValues, err := newFieldValuesDecl(structName, fields)
+		if err != nil {
+			return nil, err
+		}
+		if fieldValues != nil {
+			decls = append(decls, fieldValues)
+		}
+		if changedFields := newChangedFieldsDecl(structName, fields); changedFields != nil {
+			decls = append(decls, changedFields)
+		}
+		validate, err := newValidateDecl(structName, fields)
+		if err != nil {
+			return nil, err
+		}
+		if validate != nil {
+			decls = append(decls, newPatternDecls(fields)...)
+			decls = append(decls, validate)
+			extraImports = validationImports()
+		}
+	}
+
+	decls = append(decls, newVerifySchemaDecls(p)...)
+
+	if importDecl := p.importDecl(extraImports...); importDecl != nil {
+		decls = append([]ast.Decl{importDecl}, decls...)
 	}
 
 	return decls, nil
 }
 
+// Returns the relation fields of other proxies that point to the
+// proxy. Only proxies with collection names can be back-related.
+func (p *Parser) backRelationFields(structName string) []*Field {
+	if p.collectionNames[structName] == "" {
+		return nil
+	}
+
+	var relFields []*Field
+	for _, s := range p.structSpecs {
+		if p.collectionNames[s.Name.Name] == "" {
+			continue
+		}
+		for _, f := range p.structFields[s.Name.Name] {
+			if f.isRelation() && baseType(f.fieldType).Name == structName {
+				relFields = append(relFields, f)
+			}
+		}
+	}
+
+	return relFields
+}
+
 type Parser struct {
 	sourceCode []byte
 
@@ -155,6 +270,12 @@ type Parser struct {
 	structFields    map[string][]*Field
 	structMethods   map[string][]*ast.FuncDecl
 	collectionNames map[string]string
+	collectionIds   map[string]string
+	collectionTypes map[string]string
+
+	// Makes the generated code find the collections
+	// by their ids instead of their names
+	lookupById bool
 
 	// Tracks new identifier names that the parser finds from
 	// template comments
@@ -164,6 +285,7 @@ type Parser struct {
 	// names to prevent duplication
 	selectTypeToOptions  map[string][]string
 	selectTypeToVarNames map[string][]string
+	selectTypeToKind     map[string]string
 }
 
 func NewTemplateParser(sourceCode []byte) (*Parser, error) {
@@ -172,6 +294,7 @@ func NewTemplateParser(sourceCode []byte) (*Parser, error) {
 		newNames:             map[string]any{},
 		selectTypeToOptions:  map[string][]string{},
 		selectTypeToVarNames: map[string][]string{},
+		selectTypeToKind:     map[string]string{},
 	}
 	if err := p.parseFile(); err != nil {
 		return nil, err
@@ -183,10 +306,42 @@ func NewTemplateParser(sourceCode []byte) (*Parser, error) {
 	}
 	p.collectStructMethods()
 	p.findCollectionNames()
+	if err := p.findCollectionTypes(); err != nil {
+		return nil, err
+	}
 
 	return p, nil
 }
 
+// Makes the generated code find the collections by the ids of the
+// '// collection-id:' comments instead of by their names. The generated
+// lookups and hooks then keep working after a collection was renamed.
+// Fails if a struct with a collection name has no collection id.
+func (p *Parser) LookupCollectionsById() error {
+	missing := make([]string, 0)
+	for _, s := range p.structSpecs {
+		structName := s.Name.Name
+		if p.collectionNames[structName] != "" && p.collectionIds[structName] == "" {
+			missing = append(missing, structName)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("Can not look up the collections by id because the template structs %v have no '// collection-id:' comment on their first field.", missing)
+	}
+
+	p.lookupById = true
+	return nil
+}
+
+// Returns the id or the name that the generated
+// code uses to find the collection of a struct
+func (p *Parser) collectionRef(structName string) string {
+	if p.lookupById {
+		return p.collectionIds[structName]
+	}
+	return p.collectionNames[structName]
+}
+
 func (p *Parser) parseFile() error {
 	p.Fset = token.NewFileSet()
 
@@ -268,6 +423,7 @@ func (p *Parser) collectStructMethods() {
 
 func (p *Parser) findCollectionNames() {
 	p.collectionNames = make(map[string]string)
+	p.collectionIds = make(map[string]string)
 
 	for structName, fields := range p.structFields {
 		if len(fields) == 0 {
@@ -278,9 +434,33 @@ func (p *Parser) findCollectionNames() {
 		if cName != "" {
 			p.collectionNames[structName] = cName
 		}
+		cId := p.parseCollectionIdComment(firstField)
+		if cId != "" {
+			p.collectionIds[structName] = cId
+		}
 	}
 }
 
+func (p *Parser) findCollectionTypes() error {
+	p.collectionTypes = make(map[string]string)
+
+	for structName, fields := range p.structFields {
+		if len(fields) == 0 {
+			continue
+		}
+		firstField := fields[0].astOriginal
+		cType, err := p.parseCollectionTypeComment(firstField)
+		if err != nil {
+			return err
+		}
+		if cType != "" {
+			p.collectionTypes[structName] = cType
+		}
+	}
+
+	return nil
+}
+
 func (p *Parser) newFieldsFromAST(structName string, field *ast.Field) ([]*Field, error) {
 	if len(field.Names) == 0 {
 		return nil, ErrEmbeddedField
@@ -290,6 +470,22 @@ func (p *Parser) newFieldsFromAST(structName string, field *ast.Field) ([]*Field
 	if err != nil {
 		return nil, err
 	}
+	selectUnknown, err := p.parseSelectUnknownComment(field, selectTypeName)
+	if err != nil {
+		return nil, err
+	}
+	jsonType, err := p.parseJSONTypeComment(field)
+	if err != nil {
+		return nil, err
+	}
+	isFile, err := p.parseFileComment(field)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := p.parseConstraintComments(field)
+	if err != nil {
+		return nil, err
+	}
 	schemaName, err := p.parseAlternativeSchemaName(field)
 	if err != nil {
 		return nil, err
@@ -302,6 +498,7 @@ func (p *Parser) newFieldsFromAST(structName string, field *ast.Field) ([]*Field
 	if err != nil {
 		return nil, err
 	}
+	fieldId := p.parseFieldIdComment(field)
 
 	fields := make([]*Field, len(field.Names))
 	for i, n := range field.Names {
@@ -311,10 +508,15 @@ func (p *Parser) newFieldsFromAST(structName string, field *ast.Field) ([]*Field
 			fieldName,
 			schemaName,
 			systemFieldName,
+			fieldId,
 			field.Type,
 			selectTypeName,
 			selectOptions,
 			selectVarNames,
+			selectUnknown,
+			jsonType,
+			isFile,
+			constraints,
 			p.structNames,
 			field,
 			p,
@@ -345,13 +547,14 @@ func (p *Parser) parseSelectTypeComment(field *ast.Field) (string, []string, []s
 		return "", nil, nil, nil
 	}
 
-	typeName, err := nodeString(baseType(field.Type))
+	// int fields become iota enums, string fields string enums
+	kind, err := nodeString(baseType(field.Type))
 	if err != nil {
 		return "", nil, nil, err
 	}
-	if typeName != "int" {
+	if kind != "int" && kind != "string" {
 		pos := p.Fset.Position(astComment.Slash)
-		err = p.createError("Cannot have // select: comment on field of type other than int or []int", pos, nil)
+		err = p.createError("Cannot have // select: comment on field of type other than int, []int, string or []string", pos, nil)
 		return "", nil, nil, err
 	}
 
@@ -368,7 +571,7 @@ func (p *Parser) parseSelectTypeComment(field *ast.Field) (string, []string, []s
 	if err != nil {
 		return "", nil, nil, err
 	}
-	typeName, selectOptions, selectVarNames = p.validateSelectType(astComment.Slash, typeName, selectOptions, selectVarNames)
+	typeName, selectOptions, selectVarNames = p.validateSelectType(astComment.Slash, typeName, kind, selectOptions, selectVarNames)
 
 	return typeName, selectOptions, selectVarNames, nil
 }
@@ -468,14 +671,15 @@ func (p *Parser) checkBrackets(commentPos token.Pos, parsedComment ast.Node) (bo
 	return withVarNames, nil
 }
 
-func (p *Parser) validateSelectType(commentPos token.Pos, typeName string, selectOptions, selectVarNames []string) (string, []string, []string) {
+func (p *Parser) validateSelectType(commentPos token.Pos, typeName, kind string, selectOptions, selectVarNames []string) (string, []string, []string) {
 	origName := typeName
 	_, isDuplicate := p.newNames[typeName]
 
 	if isDuplicate {
 		otherOpts := p.selectTypeToOptions[typeName]
 		otherVars := p.selectTypeToVarNames[typeName]
-		if slices.Equal(selectOptions, otherOpts) && slices.Equal(selectVarNames, otherVars) {
+		otherKind := p.selectTypeToKind[typeName]
+		if slices.Equal(selectOptions, otherOpts) && slices.Equal(selectVarNames, otherVars) && kind == otherKind {
 			// Another field already defined the same select type. Reuse.
 			return typeName, []string{}, []string{}
 		} else {
@@ -488,9 +692,29 @@ func (p *Parser) validateSelectType(commentPos token.Pos, typeName string, selec
 		}
 	}
 
+	if _, isTaken := p.newNames[selectUnsetName(typeName)]; isTaken {
+		// The unset constant of the type would collide. Rename the type.
+		renamed := typeName
+		for i := 2; ; i += 1 {
+			renamed = fmt.Sprintf("%v%v", typeName, i)
+			_, typeTaken := p.newNames[renamed]
+			_, unsetTaken := p.newNames[selectUnsetName(renamed)]
+			if !typeTaken && !unsetTaken {
+				break
+			}
+		}
+
+		pos := p.Fset.Position(commentPos)
+		warnMsg := fmt.Sprintf("The name %v of the unset constant is already taken. Renaming the select type %v to %v", selectUnsetName(typeName), typeName, renamed)
+		p.logWarning(warnMsg, pos, nil)
+		typeName = renamed
+	}
+
 	p.newNames[typeName] = struct{}{}
+	p.newNames[selectUnsetName(typeName)] = struct{}{}
 	p.selectTypeToOptions[typeName] = selectOptions
 	p.selectTypeToVarNames[typeName] = selectVarNames
+	p.selectTypeToKind[typeName] = kind
 
 	selectVarNames = p.checkSelectVarNameDuplicates(commentPos, selectVarNames)
 
@@ -581,6 +805,127 @@ func (p *Parser) parseSystemFieldNameComment(field *ast.Field) (string, error) {
 	return systemFieldName, nil
 }
 
+var jsonTypeComment = "// json:"
+
+// Parses the type expression of a '// json: pkg.MyType' comment.
+// A bare '// json:' comment uses the type of the template field.
+// Returns nil if the field does not have the comment.
+func (p *Parser) parseJSONTypeComment(field *ast.Field) (ast.Expr, error) {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return nil, nil
+	}
+
+	comment := ""
+	var astComment *ast.Comment
+	for _, c := range field.Doc.List {
+		if len(c.Text) >= len(jsonTypeComment) && c.Text[:len(jsonTypeComment)] == jsonTypeComment {
+			comment = c.Text
+			astComment = c
+			break
+		}
+	}
+	if comment == "" {
+		return nil, nil
+	}
+
+	if len(field.Names) > 1 {
+		pos := p.Fset.Position(astComment.Slash)
+		errMsg := fmt.Sprintf("The // json: comment can only be used on fields with one identifier. Found %v.", len(field.Names))
+		return nil, p.createError(errMsg, pos, nil)
+	}
+
+	typeStr := strings.TrimSpace(comment[len(jsonTypeComment):])
+	if typeStr == "" {
+		return field.Type, nil
+	}
+	jsonType, err := parser.ParseExpr(typeStr)
+	if err != nil {
+		pos := p.Fset.Position(astComment.Slash)
+		return nil, p.createError("Malformed // json: comment. Example usage: // json: pkg.MyType", pos, nil)
+	}
+
+	return jsonType, nil
+}
+
+var selectUnknownComment = "// select-unknown:"
+
+const (
+	selectUnknownError = "error"
+	selectUnknownRaw   = "raw"
+	selectUnknownPanic = "panic"
+)
+
+// Returns the policy for unknown options of a select field from
+// its '// select-unknown:' comment. Defaults to "error".
+func (p *Parser) parseSelectUnknownComment(field *ast.Field, selectTypeName string) (string, error) {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return selectUnknownError, nil
+	}
+
+	var astComment *ast.Comment
+	for _, c := range field.Doc.List {
+		if len(c.Text) >= len(selectUnknownComment) && c.Text[:len(selectUnknownComment)] == selectUnknownComment {
+			astComment = c
+			break
+		}
+	}
+	if astComment == nil {
+		return selectUnknownError, nil
+	}
+
+	pos := p.Fset.Position(astComment.Slash)
+	if selectTypeName == "" {
+		return "", p.createError("Cannot have // select-unknown: comment on a field without // select: comment", pos, nil)
+	}
+
+	policy := strings.TrimSpace(astComment.Text[len(selectUnknownComment):])
+	switch policy {
+	case selectUnknownError, selectUnknownRaw, selectUnknownPanic:
+		return policy, nil
+	}
+	errMsg := fmt.Sprintf("Unknown select policy `%v`. Must be one of error, raw or panic.", policy)
+	return "", p.createError(errMsg, pos, nil)
+}
+
+var fileComment = "// file:"
+
+// Reports whether the field is marked as a file field
+// with a '// file:' comment.
+func (p *Parser) parseFileComment(field *ast.Field) (bool, error) {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return false, nil
+	}
+
+	var astComment *ast.Comment
+	for _, c := range field.Doc.List {
+		if len(c.Text) >= len(fileComment) && c.Text[:len(fileComment)] == fileComment {
+			astComment = c
+			break
+		}
+	}
+	if astComment == nil {
+		return false, nil
+	}
+
+	typeName, err := nodeString(field.Type)
+	if err != nil {
+		return false, err
+	}
+	if typeName != "string" && typeName != "[]string" {
+		pos := p.Fset.Position(astComment.Slash)
+		err = p.createError("Cannot have // file: comment on field of type other than string or []string", pos, nil)
+		return false, err
+	}
+
+	if len(field.Names) > 1 {
+		pos := p.Fset.Position(astComment.Slash)
+		errMsg := fmt.Sprintf("The // file: comment can only be used on fields with one identifier. Found %v.", len(field.Names))
+		return false, p.createError(errMsg, pos, nil)
+	}
+
+	return true, nil
+}
+
 var collectionNameComment = "// collection-name:"
 
 func (p *Parser) parseCollectionNameComment(field *ast.Field) string {
@@ -599,6 +944,72 @@ func (p *Parser) parseCollectionNameComment(field *ast.Field) string {
 	return collectionName
 }
 
+var collectionIdComment = "// collection-id:"
+
+// Parses the '// collection-id:' comment that keeps the
+// collection recognizable after it was renamed
+func (p *Parser) parseCollectionIdComment(field *ast.Field) string {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return ""
+	}
+
+	for _, c := range field.Doc.List {
+		if len(c.Text) >= len(collectionIdComment) && c.Text[:len(collectionIdComment)] == collectionIdComment {
+			return strings.TrimSpace(c.Text[len(collectionIdComment):])
+		}
+	}
+
+	return ""
+}
+
+var fieldIdComment = "// field-id:"
+
+// Parses the '// field-id:' comment that keeps the
+// field recognizable after it was renamed
+func (p *Parser) parseFieldIdComment(field *ast.Field) string {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return ""
+	}
+
+	for _, c := range field.Doc.List {
+		if len(c.Text) >= len(fieldIdComment) && c.Text[:len(fieldIdComment)] == fieldIdComment {
+			return strings.TrimSpace(c.Text[len(fieldIdComment):])
+		}
+	}
+
+	return ""
+}
+
+var collectionTypeComment = "// collection-type:"
+
+// Parses the '// collection-type:' comment which is only
+// present on the first field of non-base collections.
+func (p *Parser) parseCollectionTypeComment(field *ast.Field) (string, error) {
+	if field.Doc == nil || len(field.Doc.List) == 0 {
+		return "", nil
+	}
+
+	for _, c := range field.Doc.List {
+		if len(c.Text) < len(collectionTypeComment) || c.Text[:len(collectionTypeComment)] != collectionTypeComment {
+			continue
+		}
+		collectionType := strings.TrimSpace(c.Text[len(collectionTypeComment):])
+		switch collectionType {
+		case core.CollectionTypeBase, core.CollectionTypeAuth, core.CollectionTypeView:
+			return collectionType, nil
+		}
+		pos := p.Fset.Position(c.Slash)
+		errMsg := fmt.Sprintf("Unknown collection type `%v` in the // collection-type: comment.", collectionType)
+		return "", p.createError(errMsg, pos, nil)
+	}
+
+	return "", nil
+}
+
+// The '// view-query:' comment only documents the query
+// of a view collection. It is not used for generation.
+var viewQueryComment = "// view-query:"
+
 // A trailing underscore signals an identifier that could otherwise
 // not be used because it is a reserved go keyword like "type" or "func".
 // This function returns the identifier name without the trailing underscore.
@@ -662,24 +1073,60 @@ func createFuncs(fields []*Field, declare func(f *Field) (*ast.FuncDecl, error))
 	return decls, nil
 }
 
-func createSelectTypes(fields []*Field) []ast.Decl {
+func createFuncLists(fields []*Field, declare func(f *Field) ([]*ast.FuncDecl, error)) ([][]*ast.FuncDecl, error) {
+	lists := make([][]*ast.FuncDecl, 0, len(fields))
+	for _, f := range fields {
+		if f.systemFieldName == "" {
+			list, err := declare(f)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, list)
+		}
+	}
+
+	return lists, nil
+}
+
+func createSelectTypes(fields []*Field) ([]ast.Decl, error) {
 	decls := make([]ast.Decl, 0, 10)
 	for _, f := range fields {
 		if len(f.selectOptions) == 0 {
 			continue
 		}
-		decls = append(
-			decls,
-			newSelectTypeDecl(f.selectTypeName),
-			newSelectConstDecl(f),
-			newSelectMapDecl(f, true),
-			newSelectMapDecl(f, false),
-			newGetOptionFunction(f),
-			newGetOptionValueFunction(f),
-		)
+		if f.isStringSelect() {
+			decls = append(
+				decls,
+				newSelectTypeDecl(f.selectTypeName, "string"),
+				newSelectConstDecl(f),
+			)
+		} else {
+			decls = append(
+				decls,
+				newSelectTypeDecl(f.selectTypeName, "int"),
+				newSelectConstDecl(f),
+				newSelectMapDecl(f, true),
+				newSelectMapDecl(f, false),
+				newGetOptionFunction(f),
+				newGetOptionValueFunction(f),
+			)
+		}
+
+		optionsFunc, err := newSelectOptionsFuncDecl(f)
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, optionsFunc)
+		methods, err := newSelectMethodDecls(f)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range methods {
+			decls = append(decls, m)
+		}
 	}
 
-	return decls
+	return decls, nil
 }
 
 func (p *Parser) createCollectionNameGetter(structName string) *ast.FuncDecl {
@@ -698,6 +1145,29 @@ func (p *Parser) createCollectionNameGetter(structName string) *ast.FuncDecl {
 	return getterDecl
 }
 
+// Returns an import declaration with the imports of the template
+// file so that types from the template (e.g. the types of '// json:' comments)
+// resolve to the same packages. The extraSpecs are added for packages the
+// generated code needs. Unused imports are removed when printing.
+// Returns nil if there are no imports at all.
+func (p *Parser) importDecl(extraSpecs ...ast.Spec) *ast.GenDecl {
+	if len(p.fAst.Imports) == 0 && len(extraSpecs) == 0 {
+		return nil
+	}
+
+	specs := make([]ast.Spec, 0, len(p.fAst.Imports)+len(extraSpecs))
+	for _, imp := range p.fAst.Imports {
+		spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: imp.Path.Value}}
+		if imp.Name != nil {
+			spec.Name = ast.NewIdent(imp.Name.Name)
+		}
+		specs = append(specs, spec)
+	}
+	specs = append(specs, extraSpecs...)
+
+	return &ast.GenDecl{Tok: token.IMPORT, Specs: specs}
+}
+
 // Returns a *ast.TypeSpec if it specifies a struct.
 // Otherwise nil
 func structSpec(n ast.Node) *ast.TypeSpec {
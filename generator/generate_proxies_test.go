@@ -3,6 +3,8 @@ package generator_test
 import (
 	"bufio"
 	"bytes"
+	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -25,6 +27,39 @@ func (p *Minimal) Value() string {
 func (p *Minimal) SetValue(value string) {
 	p.Set("value", value)
 }
+
+func (p *Minimal) OriginalValue() string {
+	original := &Minimal{}
+	original.Record = p.Original()
+	return original.Value()
+}
+
+func (p *Minimal) ValueChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("value"), p.Original().GetRaw("value"))
+}
+
+// The record field names of the Minimal proxy
+const (
+	MinimalFieldValue ProxyField[Minimal, string] = "value"
+)
+
+// Returns the original and the current value of a field
+func (p *Minimal) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(MinimalFieldValue):
+		return p.OriginalValue(), p.Value()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Minimal) ChangedFields() []string {
+	var fields []string
+	if p.ValueChanged() {
+		fields = append(fields, string(MinimalFieldValue))
+	}
+	return fields
+}
 `
 
 	equal, err := expectGenerated(template, expectedGeneration)
@@ -47,6 +82,7 @@ func TestSingleSelectTypeField(t *testing.T) {
 const (
 	Opt1 Enum = iota
 	Opt2
+	EnumUnset Enum = -1
 )
 
 var zzEnumSelectNameMap = map[string]Enum{
@@ -58,26 +94,159 @@ var zzEnumSelectIotaMap = map[Enum]string{
 	1: "opt2",
 }
 
+func GetEnum(option Enum) string {
+	return zzEnumSelectIotaMap[option]
+}
+
+func GetEnumValue(value string) Enum {
+	i, ok := zzEnumSelectNameMap[value]
+	if !ok {
+		return EnumUnset
+	}
+	return i
+}
+
+func AllEnum() []Enum {
+	return []Enum{Opt1, Opt2}
+}
+
+func (v Enum) String() string {
+	return zzEnumSelectIotaMap[v]
+}
+
+func (v Enum) MarshalText() ([]byte, error) {
+	if v == EnumUnset {
+		return nil, nil
+	}
+	option, ok := zzEnumSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "Enum")
+	}
+	return []byte(option), nil
+}
+
+func (v *Enum) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = EnumUnset
+		return nil
+	}
+	i, ok := zzEnumSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "Enum")
+	}
+	*v = i
+	return nil
+}
+
+func (v Enum) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *Enum) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *Enum) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = EnumUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "Enum")
+}
+
+func (v Enum) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
 type HasSelect struct {
 	core.BaseRecordProxy
 }
 
 func (p *HasSelect) Value() Enum {
 	option := p.GetString("value")
+	if option == "" {
+		return EnumUnset
+	}
 	i, ok := zzEnumSelectNameMap[option]
 	if !ok {
-		panic("Unknown select value")
+		return EnumUnset
 	}
 	return i
 }
 
 func (p *HasSelect) SetValue(value Enum) {
+	if value == EnumUnset {
+		p.Set("value", "")
+		return
+	}
 	i, ok := zzEnumSelectIotaMap[value]
 	if !ok {
 		panic("Unknown select value")
 	}
 	p.Set("value", i)
 }
+
+func (p *HasSelect) ValueE() (Enum, error) {
+	option := p.GetString("value")
+	if option == "" {
+		return EnumUnset, nil
+	}
+	i, ok := zzEnumSelectNameMap[option]
+	if !ok {
+		return EnumUnset, fmt.Errorf("unknown option %q of select field %q", option, "value")
+	}
+	return i, nil
+}
+
+func (p *HasSelect) OriginalValue() Enum {
+	original := &HasSelect{}
+	original.Record = p.Original()
+	return original.Value()
+}
+
+func (p *HasSelect) ValueChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("value"), p.Original().GetRaw("value"))
+}
+
+// The record field names of the HasSelect proxy
+const (
+	HasSelectFieldValue ProxyField[HasSelect, Enum] = "value"
+)
+
+// Returns the original and the current value of a field
+func (p *HasSelect) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(HasSelectFieldValue):
+		return p.OriginalValue(), p.Value()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *HasSelect) ChangedFields() []string {
+	var fields []string
+	if p.ValueChanged() {
+		fields = append(fields, string(HasSelectFieldValue))
+	}
+	return fields
+}
 `
 
 	equal, err := expectGenerated(template, expectedGeneration)
@@ -101,6 +270,7 @@ func TestMultiSelectTypeField(t *testing.T) {
 const (
 	Opt1 Enum = iota
 	Opt2
+	EnumUnset Enum = -1
 )
 
 var zzEnumSelectNameMap = map[string]Enum{
@@ -112,6 +282,87 @@ var zzEnumSelectIotaMap = map[Enum]string{
 	1: "opt2",
 }
 
+func GetEnum(option Enum) string {
+	return zzEnumSelectIotaMap[option]
+}
+
+func GetEnumValue(value string) Enum {
+	i, ok := zzEnumSelectNameMap[value]
+	if !ok {
+		return EnumUnset
+	}
+	return i
+}
+
+func AllEnum() []Enum {
+	return []Enum{Opt1, Opt2}
+}
+
+func (v Enum) String() string {
+	return zzEnumSelectIotaMap[v]
+}
+
+func (v Enum) MarshalText() ([]byte, error) {
+	if v == EnumUnset {
+		return nil, nil
+	}
+	option, ok := zzEnumSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "Enum")
+	}
+	return []byte(option), nil
+}
+
+func (v *Enum) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = EnumUnset
+		return nil
+	}
+	i, ok := zzEnumSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "Enum")
+	}
+	*v = i
+	return nil
+}
+
+func (v Enum) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *Enum) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *Enum) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = EnumUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "Enum")
+}
+
+func (v Enum) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
 type HasSelect struct {
 	core.BaseRecordProxy
 }
@@ -122,7 +373,7 @@ func (p *HasSelect) Value() []Enum {
 	for _, o := range options {
 		i, ok := zzEnumSelectNameMap[o]
 		if !ok {
-			panic("Unknown select value")
+			continue
 		}
 		is = append(is, i)
 	}
@@ -140,6 +391,76 @@ func (p *HasSelect) SetValue(value []Enum) {
 	}
 	p.Set("value", is)
 }
+
+func (p *HasSelect) ValueE() ([]Enum, error) {
+	options := p.GetStringSlice("value")
+	is := make([]Enum, 0, len(options))
+	for _, o := range options {
+		i, ok := zzEnumSelectNameMap[o]
+		if !ok {
+			return is, fmt.Errorf("unknown option %q of select field %q", o, "value")
+		}
+		is = append(is, i)
+	}
+	return is, nil
+}
+
+func (p *HasSelect) AddValue(value ...Enum) {
+	options := make([]string, 0, len(value))
+	for _, v := range value {
+		option, ok := zzEnumSelectIotaMap[v]
+		if !ok {
+			panic("Unknown select value")
+		}
+		options = append(options, option)
+	}
+	p.Set("value+", options)
+}
+
+func (p *HasSelect) RemoveValue(value ...Enum) {
+	options := make([]string, 0, len(value))
+	for _, v := range value {
+		option, ok := zzEnumSelectIotaMap[v]
+		if !ok {
+			panic("Unknown select value")
+		}
+		options = append(options, option)
+	}
+	p.Set("value-", options)
+}
+
+func (p *HasSelect) OriginalValue() []Enum {
+	original := &HasSelect{}
+	original.Record = p.Original()
+	return original.Value()
+}
+
+func (p *HasSelect) ValueChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("value"), p.Original().GetRaw("value"))
+}
+
+// The record field names of the HasSelect proxy
+const (
+	HasSelectFieldValue ProxyField[HasSelect, []Enum] = "value"
+)
+
+// Returns the original and the current value of a field
+func (p *HasSelect) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(HasSelectFieldValue):
+		return p.OriginalValue(), p.Value()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *HasSelect) ChangedFields() []string {
+	var fields []string
+	if p.ValueChanged() {
+		fields = append(fields, string(HasSelectFieldValue))
+	}
+	return fields
+}
 `
 
 	equal, err := expectGenerated(template, expectedGeneration)
@@ -187,6 +508,65 @@ func (p *Parent) SetChild(child *Child) {
 	p.SetExpand(e)
 }
 
+func (p *Parent) ChildId() string {
+	return p.GetString("child")
+}
+
+func (p *Parent) ChildExpanded() (*Child, bool) {
+	if p.GetString("child") == "" {
+		return nil, true
+	}
+	rel, ok := p.Expand()["child"].(*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxy := &Child{}
+	proxy.Record = rel
+	return proxy, true
+}
+
+func (p *Parent) SetChildId(id string) {
+	p.Set("child", id)
+	e := p.Expand()
+	if rel, ok := e["child"].(*core.Record); ok && rel.Id != id {
+		delete(e, "child")
+		p.SetExpand(e)
+	}
+}
+
+func (p *Parent) OriginalChildId() string {
+	original := &Parent{}
+	original.Record = p.Original()
+	return original.ChildId()
+}
+
+func (p *Parent) ChildChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("child"), p.Original().GetRaw("child"))
+}
+
+// The record field names of the Parent proxy
+const (
+	ParentFieldChild ProxyField[Parent, string] = "child"
+)
+
+// Returns the original and the current value of a field
+func (p *Parent) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(ParentFieldChild):
+		return p.OriginalChildId(), p.ChildId()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Parent) ChangedFields() []string {
+	var fields []string
+	if p.ChildChanged() {
+		fields = append(fields, string(ParentFieldChild))
+	}
+	return fields
+}
+
 type Child struct {
 	core.BaseRecordProxy
 }
@@ -237,123 +617,4322 @@ func (p *Parent) SetChildren(children []*Child) {
 	p.SetExpand(e)
 }
 
-type Child struct {
-	core.BaseRecordProxy
+func (p *Parent) ChildrenIds() []string {
+	return p.GetStringSlice("children")
 }
-`
 
-	equal, err := expectGenerated(template, expectedGeneration)
-	if err != nil {
-		t.Fatalf("Error during generation: %v", err)
+func (p *Parent) ChildrenExpanded() ([]*Child, bool) {
+	if len(p.GetStringSlice("children")) == 0 {
+		return nil, true
 	}
-	if !equal {
-		t.Fatal("the multi relation field did not have the expected generation")
+	rels, ok := p.Expand()["children"].([]*core.Record)
+	if !ok {
+		return nil, false
 	}
+	proxies := make([]*Child, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Child{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, true
 }
 
-func TestAllBasicTypes(t *testing.T) {
-	template := `type AllBasicTypes struct {
-	field1 bool
-	field2 int
-	field3 float64
-	field4 string
-	field5 types.DateTime
-}
-`
-
-	expectedGeneration := `type AllBasicTypes struct {
-	core.BaseRecordProxy
-}
-
-func (p *AllBasicTypes) Field1() bool {
-	return p.GetBool("field1")
+func (p *Parent) SetChildrenIds(ids []string) {
+	p.Set("children", ids)
+	e := p.Expand()
+	if _, ok := e["children"]; ok {
+		delete(e, "children")
+		p.SetExpand(e)
+	}
 }
 
-func (p *AllBasicTypes) SetField1(field1 bool) {
-	p.Set("field1", field1)
+func (p *Parent) AddChildren(children ...*Child) {
+	ids := make([]string, len(children))
+	for i, r := range children {
+		ids[i] = r.Id
+	}
+	p.Record.Set("children+", ids)
+	e := p.Expand()
+	rels, ok := e["children"].([]*core.Record)
+	if !ok {
+		return
+	}
+	byId := make(map[string]*core.Record, len(rels)+len(children))
+	for _, r := range rels {
+		byId[r.Id] = r
+	}
+	for _, r := range children {
+		byId[r.Id] = r.Record
+	}
+	rels = make([]*core.Record, 0, len(byId))
+	for _, id := range p.GetStringSlice("children") {
+		if r, ok := byId[id]; ok {
+			rels = append(rels, r)
+		}
+	}
+	e["children"] = rels
+	p.SetExpand(e)
 }
 
-func (p *AllBasicTypes) Field2() int {
-	return p.GetInt("field2")
+func (p *Parent) RemoveChildren(children ...*Child) {
+	ids := make([]string, len(children))
+	for i, r := range children {
+		ids[i] = r.Id
+	}
+	p.Record.Set("children-", ids)
+	e := p.Expand()
+	rels, ok := e["children"].([]*core.Record)
+	if !ok {
+		return
+	}
+	byId := make(map[string]*core.Record, len(rels)+len(children))
+	for _, r := range rels {
+		byId[r.Id] = r
+	}
+	for _, r := range children {
+		byId[r.Id] = r.Record
+	}
+	rels = make([]*core.Record, 0, len(byId))
+	for _, id := range p.GetStringSlice("children") {
+		if r, ok := byId[id]; ok {
+			rels = append(rels, r)
+		}
+	}
+	e["children"] = rels
+	p.SetExpand(e)
 }
 
-func (p *AllBasicTypes) SetField2(field2 int) {
-	p.Set("field2", field2)
+func (p *Parent) HasChild(rel *Child) bool {
+	return rel != nil && slices.Contains(p.GetStringSlice("children"), rel.Id)
 }
 
-func (p *AllBasicTypes) Field3() float64 {
-	return p.GetFloat("field3")
+func (p *Parent) OriginalChildrenIds() []string {
+	original := &Parent{}
+	original.Record = p.Original()
+	return original.ChildrenIds()
 }
 
-func (p *AllBasicTypes) SetField3(field3 float64) {
-	p.Set("field3", field3)
+func (p *Parent) ChildrenChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("children"), p.Original().GetRaw("children"))
 }
 
-func (p *AllBasicTypes) Field4() string {
-	return p.GetString("field4")
-}
+// The record field names of the Parent proxy
+const (
+	ParentFieldChildren ProxyField[Parent, []string] = "children"
+)
 
-func (p *AllBasicTypes) SetField4(field4 string) {
-	p.Set("field4", field4)
+// Returns the original and the current value of a field
+func (p *Parent) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(ParentFieldChildren):
+		return p.OriginalChildrenIds(), p.ChildrenIds()
+	}
+	return nil, nil
 }
 
-func (p *AllBasicTypes) Field5() types.DateTime {
-	return p.GetDateTime("field5")
+// Returns the fields whose values differ from the original record
+func (p *Parent) ChangedFields() []string {
+	var fields []string
+	if p.ChildrenChanged() {
+		fields = append(fields, string(ParentFieldChildren))
+	}
+	return fields
 }
 
-func (p *AllBasicTypes) SetField5(field5 types.DateTime) {
-	p.Set("field5", field5)
+type Child struct {
+	core.BaseRecordProxy
 }
 `
 
-	equal, err := expectGenerated(template, expectedGeneration, "import \"github.com/pocketbase/pocketbase/tools/types\"")
+	equal, err := expectGenerated(template, expectedGeneration)
 	if err != nil {
 		t.Fatalf("Error during generation: %v", err)
 	}
 	if !equal {
-		t.Fatal("the basic typed fields did not have the expected generation")
+		t.Fatal("the multi relation field did not have the expected generation")
 	}
 }
 
-func TestRenamedField(t *testing.T) {
-	template := `type Name struct {
-	// schema-name: original_name
-	newName string
+func TestMultiRelationContainsName(t *testing.T) {
+	names := map[string]string{
+		"children":   "HasChild",
+		"tags":       "HasTag",
+		"categories": "HasCategory",
+		"addresses":  "HasAddress",
+		"boxes":      "HasBox",
+		"dishes":     "HasDish",
+		"status":     "HasStatus",
+		"alias":      "HasAlias",
+		"analysis":   "HasAnalysis",
+		"access":     "HasAccess",
+		"staff":      "HasStaff",
+	}
+
+	for fieldName, funcName := range names {
+		template := addBoilerplate(fmt.Sprintf(`type Parent struct {
+	%v []*Child
+}
+
+type Child struct {
+}
+`, fieldName))
+
+		parser, err := NewTemplateParser([]byte(template))
+		if err != nil {
+			t.Fatalf("Error during parsing: %v", err)
+		}
+		generated, err := Generate(parser, ".", "test")
+		if err != nil {
+			t.Fatalf("Error during generation: %v", err)
+		}
+		if !strings.Contains(string(generated), "func (p *Parent) "+funcName+"(") {
+			t.Fatalf("The %v field did not get a %v method", fieldName, funcName)
+		}
+	}
+}
+
+func TestRelationLoaders(t *testing.T) {
+	template := `type Parent struct {
+	// collection-name: parents
+	child    *Child
+	children []*Child
+}
+
+type Child struct {
+	// collection-name: children
+	// system: id
+	Id string
+}
+`
+
+	expectedGeneration := `type Parent struct {
+	core.BaseRecordProxy
+}
+
+func (p *Parent) CollectionName() string {
+	return "parents"
+}
+
+func (p *Parent) Child() *Child {
+	var proxy *Child
+	if rel := p.ExpandedOne("child"); rel != nil {
+		proxy = &Child{}
+		proxy.Record = rel
+	}
+	return proxy
+}
+
+func (p *Parent) SetChild(child *Child) {
+	var id string
+	if child != nil {
+		id = child.Id
+	}
+	p.Record.Set("child", id)
+	e := p.Expand()
+	if child != nil {
+		e["child"] = child.Record
+	} else {
+		delete(e, "child")
+	}
+	p.SetExpand(e)
+}
+
+func (p *Parent) ChildId() string {
+	return p.GetString("child")
+}
+
+func (p *Parent) ChildExpanded() (*Child, bool) {
+	if p.GetString("child") == "" {
+		return nil, true
+	}
+	rel, ok := p.Expand()["child"].(*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxy := &Child{}
+	proxy.Record = rel
+	return proxy, true
+}
+
+func (p *Parent) SetChildId(id string) {
+	p.Set("child", id)
+	e := p.Expand()
+	if rel, ok := e["child"].(*core.Record); ok && rel.Id != id {
+		delete(e, "child")
+		p.SetExpand(e)
+	}
+}
+
+func (p *Parent) LoadChild(app core.App) (*Child, error) {
+	id := p.GetString("child")
+	if id == "" {
+		return nil, nil
+	}
+	rel, ok := p.Expand()["child"].(*core.Record)
+	if !ok || rel.Id != id {
+		var err error
+		rel, err = app.FindRecordById("children", id)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["child"] = rel
+		p.SetExpand(e)
+	}
+	proxy := &Child{}
+	proxy.Record = rel
+	return proxy, nil
+}
+
+func (p *Parent) OriginalChildId() string {
+	original := &Parent{}
+	original.Record = p.Original()
+	return original.ChildId()
+}
+
+func (p *Parent) ChildChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("child"), p.Original().GetRaw("child"))
+}
+
+func (p *Parent) Children() []*Child {
+	rels := p.ExpandedAll("children")
+	proxies := make([]*Child, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Child{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies
+}
+
+func (p *Parent) SetChildren(children []*Child) {
+	records := make([]*core.Record, len(children))
+	ids := make([]string, len(children))
+	for i, r := range children {
+		records[i] = r.Record
+		ids[i] = r.Record.Id
+	}
+	p.Record.Set("children", ids)
+	e := p.Expand()
+	e["children"] = records
+	p.SetExpand(e)
+}
+
+func (p *Parent) ChildrenIds() []string {
+	return p.GetStringSlice("children")
+}
+
+func (p *Parent) ChildrenExpanded() ([]*Child, bool) {
+	if len(p.GetStringSlice("children")) == 0 {
+		return nil, true
+	}
+	rels, ok := p.Expand()["children"].([]*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxies := make([]*Child, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Child{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, true
+}
+
+func (p *Parent) SetChildrenIds(ids []string) {
+	p.Set("children", ids)
+	e := p.Expand()
+	if _, ok := e["children"]; ok {
+		delete(e, "children")
+		p.SetExpand(e)
+	}
+}
+
+func (p *Parent) LoadChildren(app core.App) ([]*Child, error) {
+	ids := p.GetStringSlice("children")
+	rels, ok := p.Expand()["children"].([]*core.Record)
+	if ok {
+		ok = len(rels) == len(ids)
+		for i := 0; ok && i < len(rels); i++ {
+			ok = rels[i].Id == ids[i]
+		}
+	}
+	if !ok {
+		records, err := app.FindRecordsByIds("children", ids)
+		if err != nil {
+			return nil, err
+		}
+		byId := make(map[string]*core.Record, len(records))
+		for _, r := range records {
+			byId[r.Id] = r
+		}
+		rels = make([]*core.Record, 0, len(records))
+		for _, id := range ids {
+			if r, ok := byId[id]; ok {
+				rels = append(rels, r)
+			}
+		}
+		e := p.Expand()
+		e["children"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*Child, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Child{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+func (p *Parent) AddChildren(children ...*Child) {
+	ids := make([]string, len(children))
+	for i, r := range children {
+		ids[i] = r.Id
+	}
+	p.Record.Set("children+", ids)
+	e := p.Expand()
+	rels, ok := e["children"].([]*core.Record)
+	if !ok {
+		return
+	}
+	byId := make(map[string]*core.Record, len(rels)+len(children))
+	for _, r := range rels {
+		byId[r.Id] = r
+	}
+	for _, r := range children {
+		byId[r.Id] = r.Record
+	}
+	rels = make([]*core.Record, 0, len(byId))
+	for _, id := range p.GetStringSlice("children") {
+		if r, ok := byId[id]; ok {
+			rels = append(rels, r)
+		}
+	}
+	e["children"] = rels
+	p.SetExpand(e)
+}
+
+func (p *Parent) RemoveChildren(children ...*Child) {
+	ids := make([]string, len(children))
+	for i, r := range children {
+		ids[i] = r.Id
+	}
+	p.Record.Set("children-", ids)
+	e := p.Expand()
+	rels, ok := e["children"].([]*core.Record)
+	if !ok {
+		return
+	}
+	byId := make(map[string]*core.Record, len(rels)+len(children))
+	for _, r := range rels {
+		byId[r.Id] = r
+	}
+	for _, r := range children {
+		byId[r.Id] = r.Record
+	}
+	rels = make([]*core.Record, 0, len(byId))
+	for _, id := range p.GetStringSlice("children") {
+		if r, ok := byId[id]; ok {
+			rels = append(rels, r)
+		}
+	}
+	e["children"] = rels
+	p.SetExpand(e)
+}
+
+func (p *Parent) HasChild(rel *Child) bool {
+	return rel != nil && slices.Contains(p.GetStringSlice("children"), rel.Id)
+}
+
+func (p *Parent) OriginalChildrenIds() []string {
+	original := &Parent{}
+	original.Record = p.Original()
+	return original.ChildrenIds()
+}
+
+func (p *Parent) ChildrenChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("children"), p.Original().GetRaw("children"))
+}
+
+// The record field names of the Parent proxy
+const (
+	ParentFieldChild    ProxyField[Parent, string]   = "child"
+	ParentFieldChildren ProxyField[Parent, []string] = "children"
+)
+
+// Returns the original and the current value of a field
+func (p *Parent) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(ParentFieldChild):
+		return p.OriginalChildId(), p.ChildId()
+	case string(ParentFieldChildren):
+		return p.OriginalChildrenIds(), p.ChildrenIds()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Parent) ChangedFields() []string {
+	var fields []string
+	if p.ChildChanged() {
+		fields = append(fields, string(ParentFieldChild))
+	}
+	if p.ChildrenChanged() {
+		fields = append(fields, string(ParentFieldChildren))
+	}
+	return fields
+}
+
+type Child struct {
+	core.BaseRecordProxy
+}
+
+func (p *Child) CollectionName() string {
+	return "children"
+}
+
+func (p *Child) ParentViaChild() []*Parent {
+	rels := p.ExpandedAll("parents_via_child")
+	proxies := make([]*Parent, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Parent{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies
+}
+
+func (p *Child) LoadParentViaChild(app core.App) ([]*Parent, error) {
+	var rels []*core.Record
+	switch expanded := p.Expand()["parents_via_child"].(type) {
+	case *core.Record:
+		rels = []*core.Record{expanded}
+	case []*core.Record:
+		rels = expanded
+	default:
+		params := dbx.Params{"id": p.Id}
+		var err error
+		rels, err = app.FindRecordsByFilter("parents", "child = {:id}", "", 0, 0, params)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["parents_via_child"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*Parent, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Parent{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+func (p *Child) ParentViaChildren() []*Parent {
+	rels := p.ExpandedAll("parents_via_children")
+	proxies := make([]*Parent, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Parent{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies
+}
+
+func (p *Child) LoadParentViaChildren(app core.App) ([]*Parent, error) {
+	var rels []*core.Record
+	switch expanded := p.Expand()["parents_via_children"].(type) {
+	case *core.Record:
+		rels = []*core.Record{expanded}
+	case []*core.Record:
+		rels = expanded
+	default:
+		params := dbx.Params{"id": p.Id}
+		var err error
+		rels, err = app.FindRecordsByFilter("parents", "children:each ?= {:id}", "", 0, 0, params)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["parents_via_children"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*Parent, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Parent{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+// The record field names of the Child proxy
+const (
+	ChildFieldId ProxyField[Child, string] = "id"
+)
+
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "parents", zzParentSchema))
+	errs = append(errs, verifyCollectionSchema(app, "children", zzChildSchema))
+	return errors.Join(errs...)
+}
+
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
+	}
+}
+
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
+	}
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
+	}
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// The expected schema of the parents collection
+var zzParentSchema = []proxySchemaField{
+	{
+		name:              "child",
+		types:             []string{"relation"},
+		relatedCollection: "children",
+	},
+	{
+		name:              "children",
+		types:             []string{"relation"},
+		multiple:          true,
+		relatedCollection: "children",
+	},
+}
+
+// The expected schema of the children collection
+var zzChildSchema = []proxySchemaField{}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the relation loaders did not have the expected generation result")
+	}
+}
+
+func TestRelationLoaderPlaceholderName(t *testing.T) {
+	template := `type Parent struct {
+	// collection-name: parents
+	// schema-name: collection
+	source *Child
+}
+
+type Child struct {
+	// collection-name: children
+	// system: id
+	Id string
+}
+`
+
+	expectedGeneration := `type Parent struct {
+	core.BaseRecordProxy
+}
+
+func (p *Parent) CollectionName() string {
+	return "parents"
+}
+
+func (p *Parent) Source() *Child {
+	var proxy *Child
+	if rel := p.ExpandedOne("collection"); rel != nil {
+		proxy = &Child{}
+		proxy.Record = rel
+	}
+	return proxy
+}
+
+func (p *Parent) SetSource(source *Child) {
+	var id string
+	if source != nil {
+		id = source.Id
+	}
+	p.Record.Set("collection", id)
+	e := p.Expand()
+	if source != nil {
+		e["collection"] = source.Record
+	} else {
+		delete(e, "collection")
+	}
+	p.SetExpand(e)
+}
+
+func (p *Parent) SourceId() string {
+	return p.GetString("collection")
+}
+
+func (p *Parent) SourceExpanded() (*Child, bool) {
+	if p.GetString("collection") == "" {
+		return nil, true
+	}
+	rel, ok := p.Expand()["collection"].(*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxy := &Child{}
+	proxy.Record = rel
+	return proxy, true
+}
+
+func (p *Parent) SetSourceId(id string) {
+	p.Set("collection", id)
+	e := p.Expand()
+	if rel, ok := e["collection"].(*core.Record); ok && rel.Id != id {
+		delete(e, "collection")
+		p.SetExpand(e)
+	}
+}
+
+func (p *Parent) LoadSource(app core.App) (*Child, error) {
+	id := p.GetString("collection")
+	if id == "" {
+		return nil, nil
+	}
+	rel, ok := p.Expand()["collection"].(*core.Record)
+	if !ok || rel.Id != id {
+		var err error
+		rel, err = app.FindRecordById("children", id)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["collection"] = rel
+		p.SetExpand(e)
+	}
+	proxy := &Child{}
+	proxy.Record = rel
+	return proxy, nil
+}
+
+func (p *Parent) OriginalSourceId() string {
+	original := &Parent{}
+	original.Record = p.Original()
+	return original.SourceId()
+}
+
+func (p *Parent) SourceChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("collection"), p.Original().GetRaw("collection"))
+}
+
+// The record field names of the Parent proxy
+const (
+	ParentFieldSource ProxyField[Parent, string] = "collection"
+)
+
+// Returns the original and the current value of a field
+func (p *Parent) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(ParentFieldSource):
+		return p.OriginalSourceId(), p.SourceId()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Parent) ChangedFields() []string {
+	var fields []string
+	if p.SourceChanged() {
+		fields = append(fields, string(ParentFieldSource))
+	}
+	return fields
+}
+
+type Child struct {
+	core.BaseRecordProxy
+}
+
+func (p *Child) CollectionName() string {
+	return "children"
+}
+
+func (p *Child) ParentViaSource() []*Parent {
+	rels := p.ExpandedAll("parents_via_collection")
+	proxies := make([]*Parent, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Parent{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies
+}
+
+func (p *Child) LoadParentViaSource(app core.App) ([]*Parent, error) {
+	var rels []*core.Record
+	switch expanded := p.Expand()["parents_via_collection"].(type) {
+	case *core.Record:
+		rels = []*core.Record{expanded}
+	case []*core.Record:
+		rels = expanded
+	default:
+		params := dbx.Params{"id": p.Id}
+		var err error
+		rels, err = app.FindRecordsByFilter("parents", "collection = {:id}", "", 0, 0, params)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["parents_via_collection"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*Parent, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Parent{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+// The record field names of the Child proxy
+const (
+	ChildFieldId ProxyField[Child, string] = "id"
+)
+
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "parents", zzParentSchema))
+	errs = append(errs, verifyCollectionSchema(app, "children", zzChildSchema))
+	return errors.Join(errs...)
+}
+
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
+	}
+}
+
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
+	}
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
+	}
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// The expected schema of the parents collection
+var zzParentSchema = []proxySchemaField{
+	{
+		name:              "collection",
+		types:             []string{"relation"},
+		relatedCollection: "children",
+	},
+}
+
+// The expected schema of the children collection
+var zzChildSchema = []proxySchemaField{}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the loader of a relation field named like a template placeholder did not have the expected generation result")
+	}
+}
+
+func TestBackRelations(t *testing.T) {
+	template := `type BankAccount struct {
+	// collection-name: bank_account
+	// system: id
+	Id string
+}
+
+type Person struct {
+	// collection-name: person
+	account *BankAccount
+}
+
+type NoCollectionName struct {
+	account *BankAccount
+}
+`
+
+	expectedGeneration := `type BankAccount struct {
+	core.BaseRecordProxy
+}
+
+func (p *BankAccount) CollectionName() string {
+	return "bank_account"
+}
+
+func (p *BankAccount) PersonViaAccount() []*Person {
+	rels := p.ExpandedAll("person_via_account")
+	proxies := make([]*Person, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Person{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies
+}
+
+func (p *BankAccount) LoadPersonViaAccount(app core.App) ([]*Person, error) {
+	var rels []*core.Record
+	switch expanded := p.Expand()["person_via_account"].(type) {
+	case *core.Record:
+		rels = []*core.Record{expanded}
+	case []*core.Record:
+		rels = expanded
+	default:
+		params := dbx.Params{"id": p.Id}
+		var err error
+		rels, err = app.FindRecordsByFilter("person", "account = {:id}", "", 0, 0, params)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["person_via_account"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*Person, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &Person{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+// The record field names of the BankAccount proxy
+const (
+	BankAccountFieldId ProxyField[BankAccount, string] = "id"
+)
+
+type Person struct {
+	core.BaseRecordProxy
+}
+
+func (p *Person) CollectionName() string {
+	return "person"
+}
+
+func (p *Person) Account() *BankAccount {
+	var proxy *BankAccount
+	if rel := p.ExpandedOne("account"); rel != nil {
+		proxy = &BankAccount{}
+		proxy.Record = rel
+	}
+	return proxy
+}
+
+func (p *Person) SetAccount(account *BankAccount) {
+	var id string
+	if account != nil {
+		id = account.Id
+	}
+	p.Record.Set("account", id)
+	e := p.Expand()
+	if account != nil {
+		e["account"] = account.Record
+	} else {
+		delete(e, "account")
+	}
+	p.SetExpand(e)
+}
+
+func (p *Person) AccountId() string {
+	return p.GetString("account")
+}
+
+func (p *Person) AccountExpanded() (*BankAccount, bool) {
+	if p.GetString("account") == "" {
+		return nil, true
+	}
+	rel, ok := p.Expand()["account"].(*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxy := &BankAccount{}
+	proxy.Record = rel
+	return proxy, true
+}
+
+func (p *Person) SetAccountId(id string) {
+	p.Set("account", id)
+	e := p.Expand()
+	if rel, ok := e["account"].(*core.Record); ok && rel.Id != id {
+		delete(e, "account")
+		p.SetExpand(e)
+	}
+}
+
+func (p *Person) LoadAccount(app core.App) (*BankAccount, error) {
+	id := p.GetString("account")
+	if id == "" {
+		return nil, nil
+	}
+	rel, ok := p.Expand()["account"].(*core.Record)
+	if !ok || rel.Id != id {
+		var err error
+		rel, err = app.FindRecordById("bank_account", id)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["account"] = rel
+		p.SetExpand(e)
+	}
+	proxy := &BankAccount{}
+	proxy.Record = rel
+	return proxy, nil
+}
+
+func (p *Person) OriginalAccountId() string {
+	original := &Person{}
+	original.Record = p.Original()
+	return original.AccountId()
+}
+
+func (p *Person) AccountChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("account"), p.Original().GetRaw("account"))
+}
+
+// The record field names of the Person proxy
+const (
+	PersonFieldAccount ProxyField[Person, string] = "account"
+)
+
+// Returns the original and the current value of a field
+func (p *Person) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(PersonFieldAccount):
+		return p.OriginalAccountId(), p.AccountId()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Person) ChangedFields() []string {
+	var fields []string
+	if p.AccountChanged() {
+		fields = append(fields, string(PersonFieldAccount))
+	}
+	return fields
+}
+
+type NoCollectionName struct {
+	core.BaseRecordProxy
+}
+
+func (p *NoCollectionName) Account() *BankAccount {
+	var proxy *BankAccount
+	if rel := p.ExpandedOne("account"); rel != nil {
+		proxy = &BankAccount{}
+		proxy.Record = rel
+	}
+	return proxy
+}
+
+func (p *NoCollectionName) SetAccount(account *BankAccount) {
+	var id string
+	if account != nil {
+		id = account.Id
+	}
+	p.Record.Set("account", id)
+	e := p.Expand()
+	if account != nil {
+		e["account"] = account.Record
+	} else {
+		delete(e, "account")
+	}
+	p.SetExpand(e)
+}
+
+func (p *NoCollectionName) AccountId() string {
+	return p.GetString("account")
+}
+
+func (p *NoCollectionName) AccountExpanded() (*BankAccount, bool) {
+	if p.GetString("account") == "" {
+		return nil, true
+	}
+	rel, ok := p.Expand()["account"].(*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxy := &BankAccount{}
+	proxy.Record = rel
+	return proxy, true
+}
+
+func (p *NoCollectionName) SetAccountId(id string) {
+	p.Set("account", id)
+	e := p.Expand()
+	if rel, ok := e["account"].(*core.Record); ok && rel.Id != id {
+		delete(e, "account")
+		p.SetExpand(e)
+	}
+}
+
+func (p *NoCollectionName) LoadAccount(app core.App) (*BankAccount, error) {
+	id := p.GetString("account")
+	if id == "" {
+		return nil, nil
+	}
+	rel, ok := p.Expand()["account"].(*core.Record)
+	if !ok || rel.Id != id {
+		var err error
+		rel, err = app.FindRecordById("bank_account", id)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["account"] = rel
+		p.SetExpand(e)
+	}
+	proxy := &BankAccount{}
+	proxy.Record = rel
+	return proxy, nil
+}
+
+func (p *NoCollectionName) OriginalAccountId() string {
+	original := &NoCollectionName{}
+	original.Record = p.Original()
+	return original.AccountId()
+}
+
+func (p *NoCollectionName) AccountChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("account"), p.Original().GetRaw("account"))
+}
+
+// The record field names of the NoCollectionName proxy
+const (
+	NoCollectionNameFieldAccount ProxyField[NoCollectionName, string] = "account"
+)
+
+// Returns the original and the current value of a field
+func (p *NoCollectionName) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NoCollectionNameFieldAccount):
+		return p.OriginalAccountId(), p.AccountId()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *NoCollectionName) ChangedFields() []string {
+	var fields []string
+	if p.AccountChanged() {
+		fields = append(fields, string(NoCollectionNameFieldAccount))
+	}
+	return fields
+}
+
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "bank_account", zzBankAccountSchema))
+	errs = append(errs, verifyCollectionSchema(app, "person", zzPersonSchema))
+	return errors.Join(errs...)
+}
+
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
+	}
+}
+
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
+	}
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
+	}
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// The expected schema of the bank_account collection
+var zzBankAccountSchema = []proxySchemaField{}
+
+// The expected schema of the person collection
+var zzPersonSchema = []proxySchemaField{
+	{
+		name:              "account",
+		types:             []string{"relation"},
+		relatedCollection: "bank_account",
+	},
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the back relations did not have the expected generation result")
+	}
+}
+
+func TestAllBasicTypes(t *testing.T) {
+	template := `type AllBasicTypes struct {
+	field1 bool
+	field2 int
+	field3 float64
+	field4 string
+	field5 types.DateTime
+}
+`
+
+	expectedGeneration := `type AllBasicTypes struct {
+	core.BaseRecordProxy
+}
+
+func (p *AllBasicTypes) Field1() bool {
+	return p.GetBool("field1")
+}
+
+func (p *AllBasicTypes) SetField1(field1 bool) {
+	p.Set("field1", field1)
+}
+
+func (p *AllBasicTypes) OriginalField1() bool {
+	original := &AllBasicTypes{}
+	original.Record = p.Original()
+	return original.Field1()
+}
+
+func (p *AllBasicTypes) Field1Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("field1"), p.Original().GetRaw("field1"))
+}
+
+func (p *AllBasicTypes) Field2() int {
+	return p.GetInt("field2")
+}
+
+func (p *AllBasicTypes) SetField2(field2 int) {
+	p.Set("field2", field2)
+}
+
+func (p *AllBasicTypes) IncrementField2(n int) {
+	p.Set("field2+", n)
+}
+
+func (p *AllBasicTypes) OriginalField2() int {
+	original := &AllBasicTypes{}
+	original.Record = p.Original()
+	return original.Field2()
+}
+
+func (p *AllBasicTypes) Field2Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("field2"), p.Original().GetRaw("field2"))
+}
+
+func (p *AllBasicTypes) Field3() float64 {
+	return p.GetFloat("field3")
+}
+
+func (p *AllBasicTypes) SetField3(field3 float64) {
+	p.Set("field3", field3)
+}
+
+func (p *AllBasicTypes) IncrementField3(n float64) {
+	p.Set("field3+", n)
+}
+
+func (p *AllBasicTypes) OriginalField3() float64 {
+	original := &AllBasicTypes{}
+	original.Record = p.Original()
+	return original.Field3()
+}
+
+func (p *AllBasicTypes) Field3Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("field3"), p.Original().GetRaw("field3"))
+}
+
+func (p *AllBasicTypes) Field4() string {
+	return p.GetString("field4")
+}
+
+func (p *AllBasicTypes) SetField4(field4 string) {
+	p.Set("field4", field4)
+}
+
+func (p *AllBasicTypes) OriginalField4() string {
+	original := &AllBasicTypes{}
+	original.Record = p.Original()
+	return original.Field4()
+}
+
+func (p *AllBasicTypes) Field4Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("field4"), p.Original().GetRaw("field4"))
+}
+
+func (p *AllBasicTypes) Field5() types.DateTime {
+	return p.GetDateTime("field5")
+}
+
+func (p *AllBasicTypes) SetField5(field5 types.DateTime) {
+	p.Set("field5", field5)
+}
+
+func (p *AllBasicTypes) OriginalField5() types.DateTime {
+	original := &AllBasicTypes{}
+	original.Record = p.Original()
+	return original.Field5()
+}
+
+func (p *AllBasicTypes) Field5Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("field5"), p.Original().GetRaw("field5"))
+}
+
+// The record field names of the AllBasicTypes proxy
+const (
+	AllBasicTypesFieldField1 ProxyField[AllBasicTypes, bool]           = "field1"
+	AllBasicTypesFieldField2 ProxyField[AllBasicTypes, int]            = "field2"
+	AllBasicTypesFieldField3 ProxyField[AllBasicTypes, float64]        = "field3"
+	AllBasicTypesFieldField4 ProxyField[AllBasicTypes, string]         = "field4"
+	AllBasicTypesFieldField5 ProxyField[AllBasicTypes, types.DateTime] = "field5"
+)
+
+// Returns the original and the current value of a field
+func (p *AllBasicTypes) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(AllBasicTypesFieldField1):
+		return p.OriginalField1(), p.Field1()
+	case string(AllBasicTypesFieldField2):
+		return p.OriginalField2(), p.Field2()
+	case string(AllBasicTypesFieldField3):
+		return p.OriginalField3(), p.Field3()
+	case string(AllBasicTypesFieldField4):
+		return p.OriginalField4(), p.Field4()
+	case string(AllBasicTypesFieldField5):
+		return p.OriginalField5(), p.Field5()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *AllBasicTypes) ChangedFields() []string {
+	var fields []string
+	if p.Field1Changed() {
+		fields = append(fields, string(AllBasicTypesFieldField1))
+	}
+	if p.Field2Changed() {
+		fields = append(fields, string(AllBasicTypesFieldField2))
+	}
+	if p.Field3Changed() {
+		fields = append(fields, string(AllBasicTypesFieldField3))
+	}
+	if p.Field4Changed() {
+		fields = append(fields, string(AllBasicTypesFieldField4))
+	}
+	if p.Field5Changed() {
+		fields = append(fields, string(AllBasicTypesFieldField5))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration, "import \"github.com/pocketbase/pocketbase/tools/types\"")
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the basic typed fields did not have the expected generation")
+	}
+}
+
+func TestGeoPointField(t *testing.T) {
+	template := `type Place struct {
+	location types.GeoPoint
+}
+`
+
+	expectedGeneration := `type Place struct {
+	core.BaseRecordProxy
+}
+
+func (p *Place) Location() types.GeoPoint {
+	return p.GetGeoPoint("location")
+}
+
+func (p *Place) SetLocation(location types.GeoPoint) {
+	p.Set("location", location)
+}
+
+func (p *Place) OriginalLocation() types.GeoPoint {
+	original := &Place{}
+	original.Record = p.Original()
+	return original.Location()
+}
+
+func (p *Place) LocationChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("location"), p.Original().GetRaw("location"))
+}
+
+// The record field names of the Place proxy
+const (
+	PlaceFieldLocation ProxyField[Place, types.GeoPoint] = "location"
+)
+
+// Returns the original and the current value of a field
+func (p *Place) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(PlaceFieldLocation):
+		return p.OriginalLocation(), p.Location()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Place) ChangedFields() []string {
+	var fields []string
+	if p.LocationChanged() {
+		fields = append(fields, string(PlaceFieldLocation))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration, "import \"github.com/pocketbase/pocketbase/tools/types\"")
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the geo point field did not have the expected generation result")
+	}
+}
+
+func TestRenamedField(t *testing.T) {
+	template := `type Name struct {
+	// schema-name: original_name
+	newName string
+}
+`
+
+	expectedGeneration := `type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) NewName() string {
+	return p.GetString("original_name")
+}
+
+func (p *Name) SetNewName(newName string) {
+	p.Set("original_name", newName)
+}
+
+func (p *Name) OriginalNewName() string {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.NewName()
+}
+
+func (p *Name) NewNameChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("original_name"), p.Original().GetRaw("original_name"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldNewName ProxyField[Name, string] = "original_name"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldNewName):
+		return p.OriginalNewName(), p.NewName()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.NewNameChanged() {
+		fields = append(fields, string(NameFieldNewName))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the renamed field did not have the expected generation result")
+	}
+}
+
+func TestSystemField(t *testing.T) {
+	template := `type Name struct {
+	// system: important
+	important string
+}
+`
+
+	expectedGeneration := `type Name struct {
+	core.BaseRecordProxy
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldImportant ProxyField[Name, string] = "important"
+)
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the system field did not have the expected generation")
+	}
+}
+
+func TestUnderscoreEscapedField(t *testing.T) {
+	template := `type Name struct {
+	import_ string
+}
+`
+
+	expectedGeneration := `type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) Import() string {
+	return p.GetString("import")
+}
+
+func (p *Name) SetImport(import_ string) {
+	p.Set("import", import_)
+}
+
+func (p *Name) OriginalImport() string {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Import()
+}
+
+func (p *Name) ImportChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("import"), p.Original().GetRaw("import"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldImport ProxyField[Name, string] = "import"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldImport):
+		return p.OriginalImport(), p.Import()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.ImportChanged() {
+		fields = append(fields, string(NameFieldImport))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the underscore-escaped field did not have the expected generation result")
+	}
+}
+
+func TestSelectUnknownPolicies(t *testing.T) {
+	template := `type HasSelect struct {
+	// select: RawEnum(opt1, opt2)
+	// select-unknown: raw
+	loose int
+	// select: PanicEnum(opt3, opt4)
+	// select-unknown: panic
+	panics []int
+}
+`
+
+	expectedGeneration := `type RawEnum int
+
+const (
+	Opt1 RawEnum = iota
+	Opt2
+	RawEnumUnset RawEnum = -1
+)
+
+var zzRawEnumSelectNameMap = map[string]RawEnum{
+	"opt1": 0,
+	"opt2": 1,
+}
+var zzRawEnumSelectIotaMap = map[RawEnum]string{
+	0: "opt1",
+	1: "opt2",
+}
+
+func GetRawEnum(option RawEnum) string {
+	return zzRawEnumSelectIotaMap[option]
+}
+
+func GetRawEnumValue(value string) RawEnum {
+	i, ok := zzRawEnumSelectNameMap[value]
+	if !ok {
+		return RawEnumUnset
+	}
+	return i
+}
+
+func AllRawEnum() []RawEnum {
+	return []RawEnum{Opt1, Opt2}
+}
+
+func (v RawEnum) String() string {
+	return zzRawEnumSelectIotaMap[v]
+}
+
+func (v RawEnum) MarshalText() ([]byte, error) {
+	if v == RawEnumUnset {
+		return nil, nil
+	}
+	option, ok := zzRawEnumSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "RawEnum")
+	}
+	return []byte(option), nil
+}
+
+func (v *RawEnum) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = RawEnumUnset
+		return nil
+	}
+	i, ok := zzRawEnumSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "RawEnum")
+	}
+	*v = i
+	return nil
+}
+
+func (v RawEnum) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *RawEnum) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *RawEnum) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = RawEnumUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "RawEnum")
+}
+
+func (v RawEnum) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type PanicEnum int
+
+const (
+	Opt3 PanicEnum = iota
+	Opt4
+	PanicEnumUnset PanicEnum = -1
+)
+
+var zzPanicEnumSelectNameMap = map[string]PanicEnum{
+	"opt3": 0,
+	"opt4": 1,
+}
+var zzPanicEnumSelectIotaMap = map[PanicEnum]string{
+	0: "opt3",
+	1: "opt4",
+}
+
+func GetPanicEnum(option PanicEnum) string {
+	return zzPanicEnumSelectIotaMap[option]
+}
+
+func GetPanicEnumValue(value string) PanicEnum {
+	i, ok := zzPanicEnumSelectNameMap[value]
+	if !ok {
+		return PanicEnumUnset
+	}
+	return i
+}
+
+func AllPanicEnum() []PanicEnum {
+	return []PanicEnum{Opt3, Opt4}
+}
+
+func (v PanicEnum) String() string {
+	return zzPanicEnumSelectIotaMap[v]
+}
+
+func (v PanicEnum) MarshalText() ([]byte, error) {
+	if v == PanicEnumUnset {
+		return nil, nil
+	}
+	option, ok := zzPanicEnumSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "PanicEnum")
+	}
+	return []byte(option), nil
+}
+
+func (v *PanicEnum) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = PanicEnumUnset
+		return nil
+	}
+	i, ok := zzPanicEnumSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "PanicEnum")
+	}
+	*v = i
+	return nil
+}
+
+func (v PanicEnum) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *PanicEnum) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *PanicEnum) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = PanicEnumUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "PanicEnum")
+}
+
+func (v PanicEnum) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type HasSelect struct {
+	core.BaseRecordProxy
+}
+
+func (p *HasSelect) Loose() RawEnum {
+	option := p.GetString("loose")
+	if option == "" {
+		return RawEnumUnset
+	}
+	i, ok := zzRawEnumSelectNameMap[option]
+	if !ok {
+		return RawEnumUnset
+	}
+	return i
+}
+
+func (p *HasSelect) SetLoose(loose RawEnum) {
+	if loose == RawEnumUnset {
+		p.Set("loose", "")
+		return
+	}
+	i, ok := zzRawEnumSelectIotaMap[loose]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("loose", i)
+}
+
+func (p *HasSelect) LooseE() (RawEnum, error) {
+	option := p.GetString("loose")
+	if option == "" {
+		return RawEnumUnset, nil
+	}
+	i, ok := zzRawEnumSelectNameMap[option]
+	if !ok {
+		return RawEnumUnset, nil
+	}
+	return i, nil
+}
+
+func (p *HasSelect) LooseRaw() string {
+	return p.GetString("loose")
+}
+
+func (p *HasSelect) OriginalLoose() RawEnum {
+	original := &HasSelect{}
+	original.Record = p.Original()
+	return original.Loose()
+}
+
+func (p *HasSelect) LooseChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("loose"), p.Original().GetRaw("loose"))
+}
+
+func (p *HasSelect) Panics() []PanicEnum {
+	options := p.GetStringSlice("panics")
+	is := make([]PanicEnum, 0, len(options))
+	for _, o := range options {
+		i, ok := zzPanicEnumSelectNameMap[o]
+		if !ok {
+			panic("Unknown select value")
+		}
+		is = append(is, i)
+	}
+	return is
+}
+
+func (p *HasSelect) SetPanics(panics []PanicEnum) {
+	is := make([]string, 0, len(panics))
+	for _, s := range panics {
+		i, ok := zzPanicEnumSelectIotaMap[s]
+		if !ok {
+			panic("Unknown select value")
+		}
+		is = append(is, i)
+	}
+	p.Set("panics", is)
+}
+
+func (p *HasSelect) PanicsE() ([]PanicEnum, error) {
+	options := p.GetStringSlice("panics")
+	is := make([]PanicEnum, 0, len(options))
+	for _, o := range options {
+		i, ok := zzPanicEnumSelectNameMap[o]
+		if !ok {
+			return is, fmt.Errorf("unknown option %q of select field %q", o, "panics")
+		}
+		is = append(is, i)
+	}
+	return is, nil
+}
+
+func (p *HasSelect) AddPanics(panics ...PanicEnum) {
+	options := make([]string, 0, len(panics))
+	for _, v := range panics {
+		option, ok := zzPanicEnumSelectIotaMap[v]
+		if !ok {
+			panic("Unknown select value")
+		}
+		options = append(options, option)
+	}
+	p.Set("panics+", options)
+}
+
+func (p *HasSelect) RemovePanics(panics ...PanicEnum) {
+	options := make([]string, 0, len(panics))
+	for _, v := range panics {
+		option, ok := zzPanicEnumSelectIotaMap[v]
+		if !ok {
+			panic("Unknown select value")
+		}
+		options = append(options, option)
+	}
+	p.Set("panics-", options)
+}
+
+func (p *HasSelect) OriginalPanics() []PanicEnum {
+	original := &HasSelect{}
+	original.Record = p.Original()
+	return original.Panics()
+}
+
+func (p *HasSelect) PanicsChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("panics"), p.Original().GetRaw("panics"))
+}
+
+// The record field names of the HasSelect proxy
+const (
+	HasSelectFieldLoose  ProxyField[HasSelect, RawEnum]     = "loose"
+	HasSelectFieldPanics ProxyField[HasSelect, []PanicEnum] = "panics"
+)
+
+// Returns the original and the current value of a field
+func (p *HasSelect) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(HasSelectFieldLoose):
+		return p.OriginalLoose(), p.Loose()
+	case string(HasSelectFieldPanics):
+		return p.OriginalPanics(), p.Panics()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *HasSelect) ChangedFields() []string {
+	var fields []string
+	if p.LooseChanged() {
+		fields = append(fields, string(HasSelectFieldLoose))
+	}
+	if p.PanicsChanged() {
+		fields = append(fields, string(HasSelectFieldPanics))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the select unknown policies did not have the expected generation result")
+	}
+}
+
+func TestStringSelectTypeFields(t *testing.T) {
+	template := `type Task struct {
+	// select: TaskState(todo, in_progress)
+	state string
+	// select: TaskLabel(bug, feature)[IsBug, IsFeature]
+	// select-unknown: raw
+	labels []string
+}
+`
+
+	expectedGeneration := `type TaskState string
+
+const (
+	Todo           TaskState = "todo"
+	InProgress     TaskState = "in_progress"
+	TaskStateUnset TaskState = ""
+)
+
+func AllTaskState() []TaskState {
+	return []TaskState{Todo, InProgress}
+}
+
+func (v TaskState) Values() []TaskState {
+	return AllTaskState()
+}
+
+func (v TaskState) IsValid() bool {
+	switch v {
+	case Todo, InProgress:
+		return true
+	}
+	return false
+}
+
+func (v TaskState) String() string {
+	return string(v)
+}
+
+func (v TaskState) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+func (v *TaskState) UnmarshalText(text []byte) error {
+	option := TaskState(text)
+	if option != TaskStateUnset && !option.IsValid() {
+		return fmt.Errorf("unknown option %q of select type %q", option, "TaskState")
+	}
+	*v = option
+	return nil
+}
+
+func (v TaskState) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *TaskState) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *TaskState) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = TaskStateUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "TaskState")
+}
+
+func (v TaskState) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type TaskLabel string
+
+const (
+	IsBug          TaskLabel = "bug"
+	IsFeature      TaskLabel = "feature"
+	TaskLabelUnset TaskLabel = ""
+)
+
+func AllTaskLabel() []TaskLabel {
+	return []TaskLabel{IsBug, IsFeature}
+}
+
+func (v TaskLabel) Values() []TaskLabel {
+	return AllTaskLabel()
+}
+
+func (v TaskLabel) IsValid() bool {
+	switch v {
+	case IsBug, IsFeature:
+		return true
+	}
+	return false
+}
+
+func (v TaskLabel) String() string {
+	return string(v)
+}
+
+func (v TaskLabel) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+func (v *TaskLabel) UnmarshalText(text []byte) error {
+	option := TaskLabel(text)
+	if option != TaskLabelUnset && !option.IsValid() {
+		return fmt.Errorf("unknown option %q of select type %q", option, "TaskLabel")
+	}
+	*v = option
+	return nil
+}
+
+func (v TaskLabel) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *TaskLabel) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *TaskLabel) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = TaskLabelUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "TaskLabel")
+}
+
+func (v TaskLabel) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type Task struct {
+	core.BaseRecordProxy
+}
+
+func (p *Task) State() TaskState {
+	option := TaskState(p.GetString("state"))
+	if option != TaskStateUnset && !option.IsValid() {
+		return TaskStateUnset
+	}
+	return option
+}
+
+func (p *Task) SetState(state TaskState) {
+	if state != TaskStateUnset && !state.IsValid() {
+		panic("Unknown select value")
+	}
+	p.Set("state", string(state))
+}
+
+func (p *Task) StateE() (TaskState, error) {
+	option := TaskState(p.GetString("state"))
+	if option != TaskStateUnset && !option.IsValid() {
+		return TaskStateUnset, fmt.Errorf("unknown option %q of select field %q", option, "state")
+	}
+	return option, nil
+}
+
+func (p *Task) OriginalState() TaskState {
+	original := &Task{}
+	original.Record = p.Original()
+	return original.State()
+}
+
+func (p *Task) StateChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("state"), p.Original().GetRaw("state"))
+}
+
+func (p *Task) Labels() []TaskLabel {
+	options := p.GetStringSlice("labels")
+	vs := make([]TaskLabel, 0, len(options))
+	for _, o := range options {
+		v := TaskLabel(o)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func (p *Task) SetLabels(labels []TaskLabel) {
+	vs := make([]string, 0, len(labels))
+	for _, v := range labels {
+		vs = append(vs, string(v))
+	}
+	p.Set("labels", vs)
+}
+
+func (p *Task) AddLabels(labels ...TaskLabel) {
+	options := make([]string, 0, len(labels))
+	for _, v := range labels {
+		options = append(options, string(v))
+	}
+	p.Set("labels+", options)
+}
+
+func (p *Task) RemoveLabels(labels ...TaskLabel) {
+	options := make([]string, 0, len(labels))
+	for _, v := range labels {
+		options = append(options, string(v))
+	}
+	p.Set("labels-", options)
+}
+
+func (p *Task) OriginalLabels() []TaskLabel {
+	original := &Task{}
+	original.Record = p.Original()
+	return original.Labels()
+}
+
+func (p *Task) LabelsChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("labels"), p.Original().GetRaw("labels"))
+}
+
+// The record field names of the Task proxy
+const (
+	TaskFieldState  ProxyField[Task, TaskState]   = "state"
+	TaskFieldLabels ProxyField[Task, []TaskLabel] = "labels"
+)
+
+// Returns the original and the current value of a field
+func (p *Task) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(TaskFieldState):
+		return p.OriginalState(), p.State()
+	case string(TaskFieldLabels):
+		return p.OriginalLabels(), p.Labels()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Task) ChangedFields() []string {
+	var fields []string
+	if p.StateChanged() {
+		fields = append(fields, string(TaskFieldState))
+	}
+	if p.LabelsChanged() {
+		fields = append(fields, string(TaskFieldLabels))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the string select type fields did not have the expected generation result")
+	}
+}
+
+func TestMalformedSelectUnknownComments(t *testing.T) {
+	templates := []string{
+		`type Name struct {
+	// select-unknown: raw
+	value string
+}
+`,
+		`type Name struct {
+	// select: Enum(opt1, opt2)
+	// select-unknown: ignore
+	value int
+}
+`,
+	}
+
+	for _, template := range templates {
+		template = addBoilerplate(template)
+		_, err := NewTemplateParser([]byte(template))
+		if err == nil {
+			t.Fatalf("the malformed select-unknown comment did not cause the generation to error:\n%v", template)
+		}
+	}
+}
+
+func TestRenamedSelectOptions(t *testing.T) {
+	template := `type Name struct {
+	// select: SelectTypeName(optA)[RenameA]
+	select1 int
+}
+`
+
+	expectedGeneration := `type SelectTypeName int
+
+const (
+	RenameA             SelectTypeName = iota
+	SelectTypeNameUnset SelectTypeName = -1
+)
+
+var zzSelectTypeNameSelectNameMap = map[string]SelectTypeName{"optA": 0}
+var zzSelectTypeNameSelectIotaMap = map[SelectTypeName]string{0: "optA"}
+
+func GetSelectTypeName(option SelectTypeName) string {
+	return zzSelectTypeNameSelectIotaMap[option]
+}
+
+func GetSelectTypeNameValue(value string) SelectTypeName {
+	i, ok := zzSelectTypeNameSelectNameMap[value]
+	if !ok {
+		return SelectTypeNameUnset
+	}
+	return i
+}
+
+func AllSelectTypeName() []SelectTypeName {
+	return []SelectTypeName{RenameA}
+}
+
+func (v SelectTypeName) String() string {
+	return zzSelectTypeNameSelectIotaMap[v]
+}
+
+func (v SelectTypeName) MarshalText() ([]byte, error) {
+	if v == SelectTypeNameUnset {
+		return nil, nil
+	}
+	option, ok := zzSelectTypeNameSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "SelectTypeName")
+	}
+	return []byte(option), nil
+}
+
+func (v *SelectTypeName) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = SelectTypeNameUnset
+		return nil
+	}
+	i, ok := zzSelectTypeNameSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "SelectTypeName")
+	}
+	*v = i
+	return nil
+}
+
+func (v SelectTypeName) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *SelectTypeName) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *SelectTypeName) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = SelectTypeNameUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "SelectTypeName")
+}
+
+func (v SelectTypeName) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) Select1() SelectTypeName {
+	option := p.GetString("select1")
+	if option == "" {
+		return SelectTypeNameUnset
+	}
+	i, ok := zzSelectTypeNameSelectNameMap[option]
+	if !ok {
+		return SelectTypeNameUnset
+	}
+	return i
+}
+
+func (p *Name) SetSelect1(select1 SelectTypeName) {
+	if select1 == SelectTypeNameUnset {
+		p.Set("select1", "")
+		return
+	}
+	i, ok := zzSelectTypeNameSelectIotaMap[select1]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select1", i)
+}
+
+func (p *Name) Select1E() (SelectTypeName, error) {
+	option := p.GetString("select1")
+	if option == "" {
+		return SelectTypeNameUnset, nil
+	}
+	i, ok := zzSelectTypeNameSelectNameMap[option]
+	if !ok {
+		return SelectTypeNameUnset, fmt.Errorf("unknown option %q of select field %q", option, "select1")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect1() SelectTypeName {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select1()
+}
+
+func (p *Name) Select1Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select1"), p.Original().GetRaw("select1"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldSelect1 ProxyField[Name, SelectTypeName] = "select1"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldSelect1):
+		return p.OriginalSelect1(), p.Select1()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.Select1Changed() {
+		fields = append(fields, string(NameFieldSelect1))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the renamed select options did not have the expected generation result")
+	}
+}
+
+func TestDuplicateSelectTypeNames(t *testing.T) {
+	template := `type Name struct {
+	// select: SameName(optA)
+	select1 int
+	// select: SameName(optB)
+	select2 int
+}
+`
+
+	expectedGeneration := `type SameName int
+
+const (
+	OptA          SameName = iota
+	SameNameUnset SameName = -1
+)
+
+var zzSameNameSelectNameMap = map[string]SameName{"optA": 0}
+var zzSameNameSelectIotaMap = map[SameName]string{0: "optA"}
+
+func GetSameName(option SameName) string {
+	return zzSameNameSelectIotaMap[option]
+}
+
+func GetSameNameValue(value string) SameName {
+	i, ok := zzSameNameSelectNameMap[value]
+	if !ok {
+		return SameNameUnset
+	}
+	return i
+}
+
+func AllSameName() []SameName {
+	return []SameName{OptA}
+}
+
+func (v SameName) String() string {
+	return zzSameNameSelectIotaMap[v]
+}
+
+func (v SameName) MarshalText() ([]byte, error) {
+	if v == SameNameUnset {
+		return nil, nil
+	}
+	option, ok := zzSameNameSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "SameName")
+	}
+	return []byte(option), nil
+}
+
+func (v *SameName) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = SameNameUnset
+		return nil
+	}
+	i, ok := zzSameNameSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "SameName")
+	}
+	*v = i
+	return nil
+}
+
+func (v SameName) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *SameName) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *SameName) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = SameNameUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "SameName")
+}
+
+func (v SameName) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type SameName2 int
+
+const (
+	OptB           SameName2 = iota
+	SameName2Unset SameName2 = -1
+)
+
+var zzSameName2SelectNameMap = map[string]SameName2{"optB": 0}
+var zzSameName2SelectIotaMap = map[SameName2]string{0: "optB"}
+
+func GetSameName2(option SameName2) string {
+	return zzSameName2SelectIotaMap[option]
+}
+
+func GetSameName2Value(value string) SameName2 {
+	i, ok := zzSameName2SelectNameMap[value]
+	if !ok {
+		return SameName2Unset
+	}
+	return i
+}
+
+func AllSameName2() []SameName2 {
+	return []SameName2{OptB}
+}
+
+func (v SameName2) String() string {
+	return zzSameName2SelectIotaMap[v]
+}
+
+func (v SameName2) MarshalText() ([]byte, error) {
+	if v == SameName2Unset {
+		return nil, nil
+	}
+	option, ok := zzSameName2SelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "SameName2")
+	}
+	return []byte(option), nil
+}
+
+func (v *SameName2) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = SameName2Unset
+		return nil
+	}
+	i, ok := zzSameName2SelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "SameName2")
+	}
+	*v = i
+	return nil
+}
+
+func (v SameName2) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *SameName2) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *SameName2) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = SameName2Unset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "SameName2")
+}
+
+func (v SameName2) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) Select1() SameName {
+	option := p.GetString("select1")
+	if option == "" {
+		return SameNameUnset
+	}
+	i, ok := zzSameNameSelectNameMap[option]
+	if !ok {
+		return SameNameUnset
+	}
+	return i
+}
+
+func (p *Name) SetSelect1(select1 SameName) {
+	if select1 == SameNameUnset {
+		p.Set("select1", "")
+		return
+	}
+	i, ok := zzSameNameSelectIotaMap[select1]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select1", i)
+}
+
+func (p *Name) Select1E() (SameName, error) {
+	option := p.GetString("select1")
+	if option == "" {
+		return SameNameUnset, nil
+	}
+	i, ok := zzSameNameSelectNameMap[option]
+	if !ok {
+		return SameNameUnset, fmt.Errorf("unknown option %q of select field %q", option, "select1")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect1() SameName {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select1()
+}
+
+func (p *Name) Select1Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select1"), p.Original().GetRaw("select1"))
+}
+
+func (p *Name) Select2() SameName2 {
+	option := p.GetString("select2")
+	if option == "" {
+		return SameName2Unset
+	}
+	i, ok := zzSameName2SelectNameMap[option]
+	if !ok {
+		return SameName2Unset
+	}
+	return i
+}
+
+func (p *Name) SetSelect2(select2 SameName2) {
+	if select2 == SameName2Unset {
+		p.Set("select2", "")
+		return
+	}
+	i, ok := zzSameName2SelectIotaMap[select2]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select2", i)
+}
+
+func (p *Name) Select2E() (SameName2, error) {
+	option := p.GetString("select2")
+	if option == "" {
+		return SameName2Unset, nil
+	}
+	i, ok := zzSameName2SelectNameMap[option]
+	if !ok {
+		return SameName2Unset, fmt.Errorf("unknown option %q of select field %q", option, "select2")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect2() SameName2 {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select2()
+}
+
+func (p *Name) Select2Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select2"), p.Original().GetRaw("select2"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldSelect1 ProxyField[Name, SameName]  = "select1"
+	NameFieldSelect2 ProxyField[Name, SameName2] = "select2"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldSelect1):
+		return p.OriginalSelect1(), p.Select1()
+	case string(NameFieldSelect2):
+		return p.OriginalSelect2(), p.Select2()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.Select1Changed() {
+		fields = append(fields, string(NameFieldSelect1))
+	}
+	if p.Select2Changed() {
+		fields = append(fields, string(NameFieldSelect2))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the duplicate select type names did not have the expected generation result")
+	}
+}
+
+func TestSelectUnsetNameCollisions(t *testing.T) {
+	templates := map[string]string{
+		// The var name is renamed
+		"[]Health{HealthGood, HealthUnset2}": `type Name struct {
+	// select: Health(good, unset)[HealthGood, HealthUnset]
+	health int
+}
+`,
+		// The select type is renamed
+		"type Health2 int": `type Name struct {
+	// select: Letter(a)[HealthUnset]
+	letter int
+	// select: Health(good)[HealthGood]
+	health int
+}
+`,
+	}
+
+	for expected, template := range templates {
+		parser, err := NewTemplateParser([]byte(addBoilerplate(template)))
+		if err != nil {
+			t.Fatalf("Error during parsing: %v", err)
+		}
+		proxies, err := Generate(parser, ".", "test")
+		if err != nil {
+			t.Fatalf("Error during generation: %v", err)
+		}
+		utils, err := GenerateUtils(parser, ".", "test")
+		if err != nil {
+			t.Fatalf("Error during generation of utils: %v", err)
+		}
+		if !strings.Contains(string(proxies), expected) {
+			t.Fatalf("The colliding unset constant name was not resolved with %q:\n%s", expected, proxies)
+		}
+		if err := typeCheck(proxies, utils); err != nil {
+			t.Fatalf("The proxies with a colliding unset constant name do not compile: %v", err)
+		}
+	}
+}
+
+func TestIdenticalSelectTypes(t *testing.T) {
+	template := `type Name struct {
+	// select: SameName(sameName)
+	select1 int
+	// select: SameName(sameName)
+	select2 int
+}
+`
+
+	expectedGeneration := `type SameName int
+
+const (
+	SameName2     SameName = iota
+	SameNameUnset SameName = -1
+)
+
+var zzSameNameSelectNameMap = map[string]SameName{"sameName": 0}
+var zzSameNameSelectIotaMap = map[SameName]string{0: "sameName"}
+
+func GetSameName(option SameName) string {
+	return zzSameNameSelectIotaMap[option]
+}
+
+func GetSameNameValue(value string) SameName {
+	i, ok := zzSameNameSelectNameMap[value]
+	if !ok {
+		return SameNameUnset
+	}
+	return i
+}
+
+func AllSameName() []SameName {
+	return []SameName{SameName2}
+}
+
+func (v SameName) String() string {
+	return zzSameNameSelectIotaMap[v]
+}
+
+func (v SameName) MarshalText() ([]byte, error) {
+	if v == SameNameUnset {
+		return nil, nil
+	}
+	option, ok := zzSameNameSelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "SameName")
+	}
+	return []byte(option), nil
+}
+
+func (v *SameName) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = SameNameUnset
+		return nil
+	}
+	i, ok := zzSameNameSelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "SameName")
+	}
+	*v = i
+	return nil
+}
+
+func (v SameName) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *SameName) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *SameName) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = SameNameUnset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "SameName")
+}
+
+func (v SameName) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) Select1() SameName {
+	option := p.GetString("select1")
+	if option == "" {
+		return SameNameUnset
+	}
+	i, ok := zzSameNameSelectNameMap[option]
+	if !ok {
+		return SameNameUnset
+	}
+	return i
+}
+
+func (p *Name) SetSelect1(select1 SameName) {
+	if select1 == SameNameUnset {
+		p.Set("select1", "")
+		return
+	}
+	i, ok := zzSameNameSelectIotaMap[select1]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select1", i)
+}
+
+func (p *Name) Select1E() (SameName, error) {
+	option := p.GetString("select1")
+	if option == "" {
+		return SameNameUnset, nil
+	}
+	i, ok := zzSameNameSelectNameMap[option]
+	if !ok {
+		return SameNameUnset, fmt.Errorf("unknown option %q of select field %q", option, "select1")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect1() SameName {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select1()
+}
+
+func (p *Name) Select1Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select1"), p.Original().GetRaw("select1"))
+}
+
+func (p *Name) Select2() SameName {
+	option := p.GetString("select2")
+	if option == "" {
+		return SameNameUnset
+	}
+	i, ok := zzSameNameSelectNameMap[option]
+	if !ok {
+		return SameNameUnset
+	}
+	return i
+}
+
+func (p *Name) SetSelect2(select2 SameName) {
+	if select2 == SameNameUnset {
+		p.Set("select2", "")
+		return
+	}
+	i, ok := zzSameNameSelectIotaMap[select2]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select2", i)
+}
+
+func (p *Name) Select2E() (SameName, error) {
+	option := p.GetString("select2")
+	if option == "" {
+		return SameNameUnset, nil
+	}
+	i, ok := zzSameNameSelectNameMap[option]
+	if !ok {
+		return SameNameUnset, fmt.Errorf("unknown option %q of select field %q", option, "select2")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect2() SameName {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select2()
+}
+
+func (p *Name) Select2Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select2"), p.Original().GetRaw("select2"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldSelect1 ProxyField[Name, SameName] = "select1"
+	NameFieldSelect2 ProxyField[Name, SameName] = "select2"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldSelect1):
+		return p.OriginalSelect1(), p.Select1()
+	case string(NameFieldSelect2):
+		return p.OriginalSelect2(), p.Select2()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.Select1Changed() {
+		fields = append(fields, string(NameFieldSelect1))
+	}
+	if p.Select2Changed() {
+		fields = append(fields, string(NameFieldSelect2))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the identical select types did not have the expected generation result")
+	}
+}
+
+// Runs the generated VerifySchema against the db_test app with a
+// second field that reuses the select type of the singleSelect field
+func TestVerifySchemaWithTestApp(t *testing.T) {
+	collections, err := QuerySchema("./db_test/test_pb_data", false)
+	if err != nil {
+		t.Fatalf("Error during schema query: %v", err)
+	}
+	template, err := Template(collections, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during template generation: %v", err)
+	}
+
+	singleSelect := "\t// select: AllFieldTypesSingleSelectOptions(optionA, optionB, optionC)[AllFieldTypesSingleSelectoptionA, AllFieldTypesSingleSelectoptionB, AllFieldTypesSingleSelectoptionC]\n"
+	reusedSelect := singleSelect + "\totherSelect int\n"
+	withReusedSelect := strings.Replace(string(template), singleSelect, reusedSelect+singleSelect, 1)
+	if withReusedSelect == string(template) {
+		t.Fatal("the template has no singleSelect field to reuse the select type of")
+	}
+
+	dataDir, err := filepath.Abs("./db_test/test_pb_data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runGeneratedTests(t, withReusedSelect, fmt.Sprintf(verifySchemaAppTest, dataDir))
+}
+
+const verifySchemaAppTest = `package generatedtest
+
+import (
+	"testing"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tests"
+)
+
+func TestVerifySchema(t *testing.T) {
+	app, err := tests.NewTestApp(%q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer app.Cleanup()
+
+	collection, err := app.FindCollectionByNameOrId("all_field_types")
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection.Fields.Add(&core.SelectField{Name: "otherSelect", Values: []string{"optionA", "optionB", "optionC"}, MaxSelect: 1})
+	if err := app.Save(collection); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifySchema(app); err != nil {
+		t.Fatalf("the schema of the test app did not match the proxies: %%v", err)
+	}
+}
+`
+
+func TestUnknownType(t *testing.T) {
+	template := `type Name struct {
+		illegal float32
+	}
+	`
+
+	template = addBoilerplate(template)
+	parser, _ := NewTemplateParser([]byte(template))
+	_, err := Generate(parser, ".", "test")
+	if err == nil {
+		t.Fatal("the illegally typed field did not cause the generation to error")
+	}
+}
+
+func TestIllegalSelectFieldType(t *testing.T) {
+	// Only int, []int, string or []string is allowed for select types
+	template := `type Name struct {
+		// select: IllegalSelect(optA, optB)
+		illegal float64
+	}
+	`
+
+	template = addBoilerplate(template)
+	_, err := NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the illegally typed select type field did not cause the generation to error")
+	}
+}
+
+func TestUncommentedSystemField(t *testing.T) {
+	template := `type Name struct {
+	Id string
+}
+`
+	template = addBoilerplate(template)
+	parser, _ := NewTemplateParser([]byte(template))
+	_, err := Generate(parser, ".", "test")
+	if err == nil {
+		t.Fatal("the uncommented system field did not cause the generation to error")
+	}
+}
+
+func TestMalformedSelectComment(t *testing.T) {
+	template := `type Name struct {
+	// select: (optA, optB)
+	selectVar int
+}
+`
+	template = addBoilerplate(template)
+	_, err := NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the malformed select comment did not cause the generation to error")
+	}
+
+	template = `type Name struct {
+		// select: SelectTypeName()
+		selectVar int
+}
+`
+	template = addBoilerplate(template)
+	_, err = NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the malformed select comment did not cause the generation to error")
+	}
+
+	template = `type Name struct {
+		// select: SelectTypeName(optA, optB)[nameA, nameB, nameC]
+		selectVar int
+}
+`
+	template = addBoilerplate(template)
+	_, err = NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the malformed select comment did not cause the generation to error")
+	}
+}
+
+func TestIllegalMultiNameFields(t *testing.T) {
+	template := `type Name struct {
+	select1, func_ int
+}
+`
+
+	template = addBoilerplate(template)
+	_, err := NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the illegal multi name field with an underscore escape did not cause the generation to error")
+	}
+
+	template = `type Name struct {
+		// system: both!
+		name1, name2 int
+}
+`
+
+	template = addBoilerplate(template)
+	_, err = NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the illegal multi name system field did not cause the generation to error")
+	}
+
+	template = `type Name struct {
+		// select: SelectTypeName(a, b)
+		name1, name2 int
+}
+`
+
+	template = addBoilerplate(template)
+	_, err = NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the illegal multi name select type field did not cause the generation to error")
+	}
+
+	template = `type Name struct {
+		// schema-name: both!
+		name1, name2 int
+}
+`
+
+	template = addBoilerplate(template)
+	_, err = NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the illegal multi name renamed field did not cause the generation to error")
+	}
+}
+
+func TestDuplicateSelectOption(t *testing.T) {
+	template := `type Name struct {
+	// select: SelectTypeName2(sameName)
+	select1 int
+	// select: SelectTypeName1(sameName)
+	select2 int
+}
+`
+
+	expectedGeneration := `type SelectTypeName2 int
+
+const (
+	SameName             SelectTypeName2 = iota
+	SelectTypeName2Unset SelectTypeName2 = -1
+)
+
+var zzSelectTypeName2SelectNameMap = map[string]SelectTypeName2{"sameName": 0}
+var zzSelectTypeName2SelectIotaMap = map[SelectTypeName2]string{0: "sameName"}
+
+func GetSelectTypeName2(option SelectTypeName2) string {
+	return zzSelectTypeName2SelectIotaMap[option]
+}
+
+func GetSelectTypeName2Value(value string) SelectTypeName2 {
+	i, ok := zzSelectTypeName2SelectNameMap[value]
+	if !ok {
+		return SelectTypeName2Unset
+	}
+	return i
+}
+
+func AllSelectTypeName2() []SelectTypeName2 {
+	return []SelectTypeName2{SameName}
+}
+
+func (v SelectTypeName2) String() string {
+	return zzSelectTypeName2SelectIotaMap[v]
+}
+
+func (v SelectTypeName2) MarshalText() ([]byte, error) {
+	if v == SelectTypeName2Unset {
+		return nil, nil
+	}
+	option, ok := zzSelectTypeName2SelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "SelectTypeName2")
+	}
+	return []byte(option), nil
+}
+
+func (v *SelectTypeName2) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = SelectTypeName2Unset
+		return nil
+	}
+	i, ok := zzSelectTypeName2SelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "SelectTypeName2")
+	}
+	*v = i
+	return nil
+}
+
+func (v SelectTypeName2) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *SelectTypeName2) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *SelectTypeName2) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = SelectTypeName2Unset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "SelectTypeName2")
+}
+
+func (v SelectTypeName2) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type SelectTypeName1 int
+
+const (
+	SameName2            SelectTypeName1 = iota
+	SelectTypeName1Unset SelectTypeName1 = -1
+)
+
+var zzSelectTypeName1SelectNameMap = map[string]SelectTypeName1{"sameName": 0}
+var zzSelectTypeName1SelectIotaMap = map[SelectTypeName1]string{0: "sameName"}
+
+func GetSelectTypeName1(option SelectTypeName1) string {
+	return zzSelectTypeName1SelectIotaMap[option]
+}
+
+func GetSelectTypeName1Value(value string) SelectTypeName1 {
+	i, ok := zzSelectTypeName1SelectNameMap[value]
+	if !ok {
+		return SelectTypeName1Unset
+	}
+	return i
+}
+
+func AllSelectTypeName1() []SelectTypeName1 {
+	return []SelectTypeName1{SameName2}
+}
+
+func (v SelectTypeName1) String() string {
+	return zzSelectTypeName1SelectIotaMap[v]
+}
+
+func (v SelectTypeName1) MarshalText() ([]byte, error) {
+	if v == SelectTypeName1Unset {
+		return nil, nil
+	}
+	option, ok := zzSelectTypeName1SelectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "SelectTypeName1")
+	}
+	return []byte(option), nil
+}
+
+func (v *SelectTypeName1) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = SelectTypeName1Unset
+		return nil
+	}
+	i, ok := zzSelectTypeName1SelectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "SelectTypeName1")
+	}
+	*v = i
+	return nil
+}
+
+func (v SelectTypeName1) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *SelectTypeName1) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+func (v *SelectTypeName1) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = SelectTypeName1Unset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "SelectTypeName1")
+}
+
+func (v SelectTypeName1) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) Select1() SelectTypeName2 {
+	option := p.GetString("select1")
+	if option == "" {
+		return SelectTypeName2Unset
+	}
+	i, ok := zzSelectTypeName2SelectNameMap[option]
+	if !ok {
+		return SelectTypeName2Unset
+	}
+	return i
+}
+
+func (p *Name) SetSelect1(select1 SelectTypeName2) {
+	if select1 == SelectTypeName2Unset {
+		p.Set("select1", "")
+		return
+	}
+	i, ok := zzSelectTypeName2SelectIotaMap[select1]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select1", i)
+}
+
+func (p *Name) Select1E() (SelectTypeName2, error) {
+	option := p.GetString("select1")
+	if option == "" {
+		return SelectTypeName2Unset, nil
+	}
+	i, ok := zzSelectTypeName2SelectNameMap[option]
+	if !ok {
+		return SelectTypeName2Unset, fmt.Errorf("unknown option %q of select field %q", option, "select1")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect1() SelectTypeName2 {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select1()
+}
+
+func (p *Name) Select1Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select1"), p.Original().GetRaw("select1"))
+}
+
+func (p *Name) Select2() SelectTypeName1 {
+	option := p.GetString("select2")
+	if option == "" {
+		return SelectTypeName1Unset
+	}
+	i, ok := zzSelectTypeName1SelectNameMap[option]
+	if !ok {
+		return SelectTypeName1Unset
+	}
+	return i
+}
+
+func (p *Name) SetSelect2(select2 SelectTypeName1) {
+	if select2 == SelectTypeName1Unset {
+		p.Set("select2", "")
+		return
+	}
+	i, ok := zzSelectTypeName1SelectIotaMap[select2]
+	if !ok {
+		panic("Unknown select value")
+	}
+	p.Set("select2", i)
+}
+
+func (p *Name) Select2E() (SelectTypeName1, error) {
+	option := p.GetString("select2")
+	if option == "" {
+		return SelectTypeName1Unset, nil
+	}
+	i, ok := zzSelectTypeName1SelectNameMap[option]
+	if !ok {
+		return SelectTypeName1Unset, fmt.Errorf("unknown option %q of select field %q", option, "select2")
+	}
+	return i, nil
+}
+
+func (p *Name) OriginalSelect2() SelectTypeName1 {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.Select2()
+}
+
+func (p *Name) Select2Changed() bool {
+	return !reflect.DeepEqual(p.GetRaw("select2"), p.Original().GetRaw("select2"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldSelect1 ProxyField[Name, SelectTypeName2] = "select1"
+	NameFieldSelect2 ProxyField[Name, SelectTypeName1] = "select2"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldSelect1):
+		return p.OriginalSelect1(), p.Select1()
+	case string(NameFieldSelect2):
+		return p.OriginalSelect2(), p.Select2()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.Select1Changed() {
+		fields = append(fields, string(NameFieldSelect1))
+	}
+	if p.Select2Changed() {
+		fields = append(fields, string(NameFieldSelect2))
+	}
+	return fields
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the duplicate select option names did not have the expected generation result")
+	}
+}
+
+func TestCollectionNameGetter(t *testing.T) {
+	template := `type Name struct {
+	// collection-name: collection_name
+	firstField string
+}
+`
+
+	expectedGeneration := `type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) CollectionName() string {
+	return "collection_name"
+}
+
+func (p *Name) FirstField() string {
+	return p.GetString("firstField")
+}
+
+func (p *Name) SetFirstField(firstField string) {
+	p.Set("firstField", firstField)
+}
+
+func (p *Name) OriginalFirstField() string {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.FirstField()
+}
+
+func (p *Name) FirstFieldChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("firstField"), p.Original().GetRaw("firstField"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldFirstField ProxyField[Name, string] = "firstField"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldFirstField):
+		return p.OriginalFirstField(), p.FirstField()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.FirstFieldChanged() {
+		fields = append(fields, string(NameFieldFirstField))
+	}
+	return fields
+}
+
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "collection_name", zzNameSchema))
+	return errors.Join(errs...)
+}
+
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
+	}
+}
+
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
+	}
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
+	}
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// The expected schema of the collection_name collection
+var zzNameSchema = []proxySchemaField{
+	{
+		name: "firstField",
+		types: []string{
+			"text", "email", "url", "editor", "password", "select", "file", "json",
+		},
+	},
+}
+`
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the collection name comment did not have the expected generation result")
+	}
+}
+
+func TestCollectionIdLookup(t *testing.T) {
+	template := `type Name struct {
+	// collection-name: collection_name
+	// collection-id: pbc_123456
+	firstField string
+}
+`
+
+	expectedGeneration := `type Name struct {
+	core.BaseRecordProxy
+}
+
+func (p *Name) CollectionName() string {
+	return "collection_name"
+}
+
+func (p *Name) CollectionId() string {
+	return "pbc_123456"
+}
+
+func (p *Name) FirstField() string {
+	return p.GetString("firstField")
+}
+
+func (p *Name) SetFirstField(firstField string) {
+	p.Set("firstField", firstField)
+}
+
+func (p *Name) OriginalFirstField() string {
+	original := &Name{}
+	original.Record = p.Original()
+	return original.FirstField()
+}
+
+func (p *Name) FirstFieldChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("firstField"), p.Original().GetRaw("firstField"))
+}
+
+// The record field names of the Name proxy
+const (
+	NameFieldFirstField ProxyField[Name, string] = "firstField"
+)
+
+// Returns the original and the current value of a field
+func (p *Name) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(NameFieldFirstField):
+		return p.OriginalFirstField(), p.FirstField()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *Name) ChangedFields() []string {
+	var fields []string
+	if p.FirstFieldChanged() {
+		fields = append(fields, string(NameFieldFirstField))
+	}
+	return fields
+}
+
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "pbc_123456", zzNameSchema))
+	return errors.Join(errs...)
+}
+
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
+	}
+}
+
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
+	}
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
+	}
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// The expected schema of the collection_name collection
+var zzNameSchema = []proxySchemaField{
+	{
+		name: "firstField",
+		types: []string{
+			"text", "email", "url", "editor", "password", "select", "file", "json",
+		},
+	},
+}
+`
+
+	template = addBoilerplate(template)
+	parser, err := NewTemplateParser([]byte(template))
+	if err != nil {
+		t.Fatalf("Error during parsing: %v", err)
+	}
+	if err := parser.LookupCollectionsById(); err != nil {
+		t.Fatalf("Error during the id lookup setup: %v", err)
+	}
+	outBytes, err := Generate(parser, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if removeBoilerplate(outBytes) != expectedGeneration {
+		t.Fatal("the collection id comment did not have the expected generation result")
+	}
+}
+
+func TestMissingCollectionIdLookup(t *testing.T) {
+	template := `type Name struct {
+	// collection-name: collection_name
+	firstField string
+}
+`
+	template = addBoilerplate(template)
+	parser, err := NewTemplateParser([]byte(template))
+	if err != nil {
+		t.Fatalf("Error during parsing: %v", err)
+	}
+	if err := parser.LookupCollectionsById(); err == nil {
+		t.Fatal("the id lookup of a struct without a collection id did not cause an error")
+	}
+}
+
+func TestJSONTypeField(t *testing.T) {
+	template := `type HasJSON struct {
+	// json: map[string]int
+	counts string
+	// json:
+	hosts []netip.Addr
 }
 `
 
-	expectedGeneration := `type Name struct {
-	core.BaseRecordProxy
+	expectedGeneration := `type HasJSON struct {
+	core.BaseRecordProxy
+}
+
+func (p *HasJSON) Counts() map[string]int {
+	var value map[string]int
+	_ = p.UnmarshalJSONField("counts", &value)
+	return value
+}
+
+func (p *HasJSON) SetCounts(counts map[string]int) {
+	p.Set("counts", counts)
+}
+
+func (p *HasJSON) CountsE() (map[string]int, error) {
+	var value map[string]int
+	if p.GetString("counts") == "" {
+		return value, nil
+	}
+	err := p.UnmarshalJSONField("counts", &value)
+	return value, err
+}
+
+func (p *HasJSON) SetCountsE(value map[string]int) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	p.Set("counts", types.JSONRaw(raw))
+	return nil
+}
+
+func (p *HasJSON) OriginalCounts() map[string]int {
+	original := &HasJSON{}
+	original.Record = p.Original()
+	return original.Counts()
+}
+
+func (p *HasJSON) CountsChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("counts"), p.Original().GetRaw("counts"))
+}
+
+func (p *HasJSON) Hosts() []netip.Addr {
+	var value []netip.Addr
+	_ = p.UnmarshalJSONField("hosts", &value)
+	return value
 }
 
-func (p *Name) NewName() string {
-	return p.GetString("original_name")
+func (p *HasJSON) SetHosts(hosts []netip.Addr) {
+	p.Set("hosts", hosts)
 }
 
-func (p *Name) SetNewName(newName string) {
-	p.Set("original_name", newName)
+func (p *HasJSON) HostsE() ([]netip.Addr, error) {
+	var value []netip.Addr
+	if p.GetString("hosts") == "" {
+		return value, nil
+	}
+	err := p.UnmarshalJSONField("hosts", &value)
+	return value, err
+}
+
+func (p *HasJSON) SetHostsE(value []netip.Addr) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	p.Set("hosts", types.JSONRaw(raw))
+	return nil
+}
+
+func (p *HasJSON) OriginalHosts() []netip.Addr {
+	original := &HasJSON{}
+	original.Record = p.Original()
+	return original.Hosts()
+}
+
+func (p *HasJSON) HostsChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("hosts"), p.Original().GetRaw("hosts"))
+}
+
+// The record field names of the HasJSON proxy
+const (
+	HasJSONFieldCounts ProxyField[HasJSON, map[string]int] = "counts"
+	HasJSONFieldHosts  ProxyField[HasJSON, []netip.Addr]   = "hosts"
+)
+
+// Returns the original and the current value of a field
+func (p *HasJSON) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(HasJSONFieldCounts):
+		return p.OriginalCounts(), p.Counts()
+	case string(HasJSONFieldHosts):
+		return p.OriginalHosts(), p.Hosts()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *HasJSON) ChangedFields() []string {
+	var fields []string
+	if p.CountsChanged() {
+		fields = append(fields, string(HasJSONFieldCounts))
+	}
+	if p.HostsChanged() {
+		fields = append(fields, string(HasJSONFieldHosts))
+	}
+	return fields
 }
 `
 
-	equal, err := expectGenerated(template, expectedGeneration)
+	equal, err := expectGenerated(template, expectedGeneration, "import \"net/netip\"")
 	if err != nil {
 		t.Fatalf("Error during generation: %v", err)
 	}
 	if !equal {
-		t.Fatal("the renamed field did not have the expected generation result")
+		t.Fatal("the json typed fields did not have the expected generation result")
 	}
 }
 
-func TestSystemField(t *testing.T) {
+func TestMalformedJSONComment(t *testing.T) {
 	template := `type Name struct {
-	// system: important
-	important string
+	// json: map[string
+	value string
+}
+`
+	template = addBoilerplate(template)
+	_, err := NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the malformed json comment did not cause the generation to error")
+	}
+}
+
+func TestFileField(t *testing.T) {
+	template := `type HasFiles struct {
+	// file:
+	avatar string
+	// file:
+	documents []string
 }
 `
 
-	expectedGeneration := `type Name struct {
+	expectedGeneration := `type HasFiles struct {
 	core.BaseRecordProxy
 }
+
+func (p *HasFiles) Avatar() string {
+	return p.GetString("avatar")
+}
+
+func (p *HasFiles) SetAvatar(avatar string) {
+	p.Set("avatar", avatar)
+}
+
+func (p *HasFiles) SetAvatarFile(file *filesystem.File) {
+	p.Set("avatar", file)
+}
+
+func (p *HasFiles) AvatarURL() string {
+	name := p.GetString("avatar")
+	if name == "" {
+		return ""
+	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name
+}
+
+func (p *HasFiles) AvatarThumbURL(thumb string) string {
+	name := p.GetString("avatar")
+	if name == "" {
+		return ""
+	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name + "?thumb=" + thumb
+}
+
+func (p *HasFiles) OriginalAvatar() string {
+	original := &HasFiles{}
+	original.Record = p.Original()
+	return original.Avatar()
+}
+
+func (p *HasFiles) AvatarChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("avatar"), p.Original().GetRaw("avatar"))
+}
+
+func (p *HasFiles) Documents() []string {
+	return p.GetStringSlice("documents")
+}
+
+func (p *HasFiles) SetDocuments(documents []string) {
+	p.Set("documents", documents)
+}
+
+func (p *HasFiles) SetDocumentsFiles(files []*filesystem.File) {
+	p.Set("documents", files)
+}
+
+func (p *HasFiles) AppendDocuments(files ...*filesystem.File) {
+	p.Set("documents+", files)
+}
+
+func (p *HasFiles) RemoveDocuments(names ...string) {
+	p.Set("documents-", names)
+}
+
+func (p *HasFiles) DocumentsURLs() []string {
+	names := p.GetStringSlice("documents")
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = "/api/files/" + p.BaseFilesPath() + "/" + name
+	}
+	return paths
+}
+
+func (p *HasFiles) DocumentsThumbURLs(thumb string) []string {
+	names := p.GetStringSlice("documents")
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = "/api/files/" + p.BaseFilesPath() + "/" + name + "?thumb=" + thumb
+	}
+	return paths
+}
+
+func (p *HasFiles) OriginalDocuments() []string {
+	original := &HasFiles{}
+	original.Record = p.Original()
+	return original.Documents()
+}
+
+func (p *HasFiles) DocumentsChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("documents"), p.Original().GetRaw("documents"))
+}
+
+// The record field names of the HasFiles proxy
+const (
+	HasFilesFieldAvatar    ProxyField[HasFiles, string]   = "avatar"
+	HasFilesFieldDocuments ProxyField[HasFiles, []string] = "documents"
+)
+
+// Returns the original and the current value of a field
+func (p *HasFiles) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(HasFilesFieldAvatar):
+		return p.OriginalAvatar(), p.Avatar()
+	case string(HasFilesFieldDocuments):
+		return p.OriginalDocuments(), p.Documents()
+	}
+	return nil, nil
+}
+
+// Returns the fields whose values differ from the original record
+func (p *HasFiles) ChangedFields() []string {
+	var fields []string
+	if p.AvatarChanged() {
+		fields = append(fields, string(HasFilesFieldAvatar))
+	}
+	if p.DocumentsChanged() {
+		fields = append(fields, string(HasFilesFieldDocuments))
+	}
+	return fields
+}
 `
 
 	equal, err := expectGenerated(template, expectedGeneration)
@@ -361,71 +4940,239 @@ func TestSystemField(t *testing.T) {
 		t.Fatalf("Error during generation: %v", err)
 	}
 	if !equal {
-		t.Fatal("the system field did not have the expected generation")
+		t.Fatal("the file fields did not have the expected generation result")
 	}
 }
 
-func TestUnderscoreEscapedField(t *testing.T) {
+func TestFileCommentOnWrongType(t *testing.T) {
 	template := `type Name struct {
-	import_ string
+	// file:
+	value int
 }
 `
+	template = addBoilerplate(template)
+	_, err := NewTemplateParser([]byte(template))
+	if err == nil {
+		t.Fatal("the file comment on an int field did not cause the generation to error")
+	}
+}
 
-	expectedGeneration := `type Name struct {
+func TestFieldConstraints(t *testing.T) {
+	template := `type Post struct {
+	// required:
+	// min: 3
+	// pattern: ^[a-z ]+$
+	title string
+	// max: 10
+	score float64
+	// only-domains: email(example.com, example.org)
+	contact string
+	// file:
+	// max-size: 1024
+	cover string
+	// pattern: url(.*)
+	link string
+}
+`
+
+	expectedGeneration := `type Post struct {
 	core.BaseRecordProxy
 }
 
-func (p *Name) Import() string {
-	return p.GetString("import")
+func (p *Post) Title() string {
+	return p.GetString("title")
 }
 
-func (p *Name) SetImport(import_ string) {
-	p.Set("import", import_)
+func (p *Post) SetTitle(title string) {
+	p.Set("title", title)
 }
-`
 
-	equal, err := expectGenerated(template, expectedGeneration)
-	if err != nil {
-		t.Fatalf("Error during generation: %v", err)
+func (p *Post) OriginalTitle() string {
+	original := &Post{}
+	original.Record = p.Original()
+	return original.Title()
+}
+
+func (p *Post) TitleChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("title"), p.Original().GetRaw("title"))
+}
+
+func (p *Post) Score() float64 {
+	return p.GetFloat("score")
+}
+
+func (p *Post) SetScore(score float64) {
+	p.Set("score", score)
+}
+
+func (p *Post) IncrementScore(n float64) {
+	p.Set("score+", n)
+}
+
+func (p *Post) OriginalScore() float64 {
+	original := &Post{}
+	original.Record = p.Original()
+	return original.Score()
+}
+
+func (p *Post) ScoreChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("score"), p.Original().GetRaw("score"))
+}
+
+func (p *Post) Contact() string {
+	return p.GetString("contact")
+}
+
+func (p *Post) SetContact(contact string) {
+	p.Set("contact", contact)
+}
+
+func (p *Post) OriginalContact() string {
+	original := &Post{}
+	original.Record = p.Original()
+	return original.Contact()
+}
+
+func (p *Post) ContactChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("contact"), p.Original().GetRaw("contact"))
+}
+
+func (p *Post) Cover() string {
+	return p.GetString("cover")
+}
+
+func (p *Post) SetCover(cover string) {
+	p.Set("cover", cover)
+}
+
+func (p *Post) SetCoverFile(file *filesystem.File) {
+	p.Set("cover", file)
+}
+
+func (p *Post) CoverURL() string {
+	name := p.GetString("cover")
+	if name == "" {
+		return ""
 	}
-	if !equal {
-		t.Fatal("the underscore-escaped field did not have the expected generation result")
+	return "/api/files/" + p.BaseFilesPath() + "/" + name
+}
+
+func (p *Post) CoverThumbURL(thumb string) string {
+	name := p.GetString("cover")
+	if name == "" {
+		return ""
 	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name + "?thumb=" + thumb
 }
 
-func TestRenamedSelectOptions(t *testing.T) {
-	template := `type Name struct {
-	// select: SelectTypeName(optA)[RenameA]
-	select1 int
+func (p *Post) OriginalCover() string {
+	original := &Post{}
+	original.Record = p.Original()
+	return original.Cover()
 }
-`
 
-	expectedGeneration := `type SelectTypeName int
+func (p *Post) CoverChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("cover"), p.Original().GetRaw("cover"))
+}
 
-const RenameA SelectTypeName = iota
+func (p *Post) Link() string {
+	return p.GetString("link")
+}
 
-var zzSelectTypeNameSelectNameMap = map[string]SelectTypeName{"optA": 0}
-var zzSelectTypeNameSelectIotaMap = map[SelectTypeName]string{0: "optA"}
+func (p *Post) SetLink(link string) {
+	p.Set("link", link)
+}
 
-type Name struct {
-	core.BaseRecordProxy
+func (p *Post) OriginalLink() string {
+	original := &Post{}
+	original.Record = p.Original()
+	return original.Link()
 }
 
-func (p *Name) Select1() SelectTypeName {
-	option := p.GetString("select1")
-	i, ok := zzSelectTypeNameSelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
+func (p *Post) LinkChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("link"), p.Original().GetRaw("link"))
+}
+
+// The record field names of the Post proxy
+const (
+	PostFieldTitle   ProxyField[Post, string]  = "title"
+	PostFieldScore   ProxyField[Post, float64] = "score"
+	PostFieldContact ProxyField[Post, string]  = "contact"
+	PostFieldCover   ProxyField[Post, string]  = "cover"
+	PostFieldLink    ProxyField[Post, string]  = "link"
+)
+
+// Returns the original and the current value of a field
+func (p *Post) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(PostFieldTitle):
+		return p.OriginalTitle(), p.Title()
+	case string(PostFieldScore):
+		return p.OriginalScore(), p.Score()
+	case string(PostFieldContact):
+		return p.OriginalContact(), p.Contact()
+	case string(PostFieldCover):
+		return p.OriginalCover(), p.Cover()
+	case string(PostFieldLink):
+		return p.OriginalLink(), p.Link()
 	}
-	return i
+	return nil, nil
 }
 
-func (p *Name) SetSelect1(select1 SelectTypeName) {
-	i, ok := zzSelectTypeNameSelectIotaMap[select1]
-	if !ok {
-		panic("Unknown select value")
+// Returns the fields whose values differ from the original record
+func (p *Post) ChangedFields() []string {
+	var fields []string
+	if p.TitleChanged() {
+		fields = append(fields, string(PostFieldTitle))
 	}
-	p.Set("select1", i)
+	if p.ScoreChanged() {
+		fields = append(fields, string(PostFieldScore))
+	}
+	if p.ContactChanged() {
+		fields = append(fields, string(PostFieldContact))
+	}
+	if p.CoverChanged() {
+		fields = append(fields, string(PostFieldCover))
+	}
+	if p.LinkChanged() {
+		fields = append(fields, string(PostFieldLink))
+	}
+	return fields
+}
+
+var zzPostTitlePattern = regexp.MustCompile(` + "`^[a-z ]+$`" + `)
+var zzPostLinkPattern = regexp.MustCompile(` + "`url(.*)`" + `)
+
+func (p *Post) Validate() error {
+	errs := validation.Errors{}
+	if value := p.GetString("title"); errs["title"] == nil && value == "" {
+		errs["title"] = validation.ErrRequired
+	}
+	if value := p.GetString("title"); errs["title"] == nil && value != "" && utf8.RuneCountInString(value) < 3 {
+		errs["title"] = validation.NewError("validation_min_text_constraint", "Must be at least {{.min}} character(s)").SetParams(map[string]any{"min": 3})
+	}
+	if value := p.GetString("title"); errs["title"] == nil && value != "" && !zzPostTitlePattern.MatchString(value) {
+		errs["title"] = validation.NewError("validation_invalid_format", "Invalid value format")
+	}
+	if value := p.GetFloat("score"); errs["score"] == nil && value != 0 && value > 10 {
+		errs["score"] = validation.NewError("validation_max_number_constraint", "Must be less than 10.000000")
+	}
+	if value := p.GetString("contact"); errs["contact"] == nil && value != "" {
+		switch value[strings.LastIndex(value, "@")+1:] {
+		case "example.com", "example.org":
+		default:
+			errs["contact"] = validation.NewError("validation_email_domain_not_allowed", "Email domain is not allowed")
+		}
+	}
+	for _, file := range p.GetUnsavedFiles("cover") {
+		if err := validators.UploadedFileSize(1024)(file); errs["cover"] == nil && err != nil {
+			errs["cover"] = err
+		}
+	}
+	if value := p.GetString("link"); errs["link"] == nil && value != "" && !zzPostLinkPattern.MatchString(value) {
+		errs["link"] = validation.NewError("validation_invalid_format", "Invalid value format")
+	}
+	return errs.Filter()
 }
 `
 
@@ -434,330 +5181,460 @@ func (p *Name) SetSelect1(select1 SelectTypeName) {
 		t.Fatalf("Error during generation: %v", err)
 	}
 	if !equal {
-		t.Fatal("the renamed select options did not have the expected generation result")
+		t.Fatal("the constrained fields did not have the expected generation result")
 	}
 }
 
-func TestDuplicateSelectTypeNames(t *testing.T) {
-	template := `type Name struct {
-	// select: SameName(optA)
-	select1 int
-	// select: SameName(optB)
-	select2 int
+func TestMalformedConstraintComments(t *testing.T) {
+	templates := []string{
+		`type Name struct {
+	// min: three
+	value string
+}
+`,
+		`type Name struct {
+	// pattern: [a-z
+	value string
+}
+`,
+		`type Name struct {
+	// max-select: 2
+	value string
+}
+`,
+		`type Name struct {
+	// only-domains: example.com
+	value string
+}
+`,
+	}
+
+	for _, template := range templates {
+		template = addBoilerplate(template)
+		_, err := NewTemplateParser([]byte(template))
+		if err == nil {
+			t.Fatalf("the malformed constraint comment did not cause the generation to error:\n%v", template)
+		}
+	}
+}
+
+func TestAuthCollection(t *testing.T) {
+	template := `type User struct {
+	// collection-name: users
+	// collection-type: auth
+	// system: id
+	Id string
+	// system: password
+	password string
+	// system: tokenKey
+	tokenKey string
+	// system: email
+	email string
+	// system: emailVisibility
+	emailVisibility bool
+	// system: verified
+	verified bool
+	name     string
 }
 `
 
-	expectedGeneration := `type SameName int
+	expectedGeneration := `type User struct {
+	core.BaseRecordProxy
+}
 
-const OptA SameName = iota
+func (p *User) CollectionName() string {
+	return "users"
+}
 
-var zzSameNameSelectNameMap = map[string]SameName{"optA": 0}
-var zzSameNameSelectIotaMap = map[SameName]string{0: "optA"}
+func (p *User) Email() string {
+	return p.Record.Email()
+}
 
-type SameName2 int
+func (p *User) SetEmail(email string) {
+	p.Record.SetEmail(email)
+}
 
-const OptB SameName2 = iota
+func (p *User) EmailVisibility() bool {
+	return p.Record.EmailVisibility()
+}
 
-var zzSameName2SelectNameMap = map[string]SameName2{"optB": 0}
-var zzSameName2SelectIotaMap = map[SameName2]string{0: "optB"}
+func (p *User) SetEmailVisibility(visible bool) {
+	p.Record.SetEmailVisibility(visible)
+}
 
-type Name struct {
-	core.BaseRecordProxy
+func (p *User) Verified() bool {
+	return p.Record.Verified()
 }
 
-func (p *Name) Select1() SameName {
-	option := p.GetString("select1")
-	i, ok := zzSameNameSelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
-	}
-	return i
+func (p *User) SetVerified(verified bool) {
+	p.Record.SetVerified(verified)
 }
 
-func (p *Name) SetSelect1(select1 SameName) {
-	i, ok := zzSameNameSelectIotaMap[select1]
-	if !ok {
-		panic("Unknown select value")
-	}
-	p.Set("select1", i)
+func (p *User) SetPassword(password string) {
+	p.Record.SetPassword(password)
 }
 
-func (p *Name) Select2() SameName2 {
-	option := p.GetString("select2")
-	i, ok := zzSameName2SelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
-	}
-	return i
+func (p *User) SetRandomPassword() string {
+	return p.Record.SetRandomPassword()
 }
 
-func (p *Name) SetSelect2(select2 SameName2) {
-	i, ok := zzSameName2SelectIotaMap[select2]
-	if !ok {
-		panic("Unknown select value")
-	}
-	p.Set("select2", i)
+func (p *User) ValidatePassword(password string) bool {
+	return p.Record.ValidatePassword(password)
+}
+
+func (p *User) RefreshTokenKey() {
+	p.Record.RefreshTokenKey()
+}
+
+func (p *User) NewAuthToken() (string, error) {
+	return p.Record.NewAuthToken()
+}
+
+func (p *User) NewStaticAuthToken(duration time.Duration) (string, error) {
+	return p.Record.NewStaticAuthToken(duration)
+}
+
+func (p *User) NewVerificationToken() (string, error) {
+	return p.Record.NewVerificationToken()
+}
+
+func (p *User) NewPasswordResetToken() (string, error) {
+	return p.Record.NewPasswordResetToken()
 }
-`
 
-	equal, err := expectGenerated(template, expectedGeneration)
-	if err != nil {
-		t.Fatalf("Error during generation: %v", err)
-	}
-	if !equal {
-		t.Fatal("the duplicate select type names did not have the expected generation result")
-	}
+func (p *User) NewEmailChangeToken(newEmail string) (string, error) {
+	return p.Record.NewEmailChangeToken(newEmail)
 }
 
-func TestIdenticalSelectTypes(t *testing.T) {
-	template := `type Name struct {
-	// select: SameName(sameName)
-	select1 int
-	// select: SameName(sameName)
-	select2 int
+func (p *User) NewFileToken() (string, error) {
+	return p.Record.NewFileToken()
 }
-`
 
-	expectedGeneration := `type SameName int
+func (p *User) Name() string {
+	return p.GetString("name")
+}
 
-const SameName2 SameName = iota
+func (p *User) SetName(name string) {
+	p.Set("name", name)
+}
 
-var zzSameNameSelectNameMap = map[string]SameName{"sameName": 0}
-var zzSameNameSelectIotaMap = map[SameName]string{0: "sameName"}
+func (p *User) OriginalName() string {
+	original := &User{}
+	original.Record = p.Original()
+	return original.Name()
+}
 
-type Name struct {
-	core.BaseRecordProxy
+func (p *User) NameChanged() bool {
+	return !reflect.DeepEqual(p.GetRaw("name"), p.Original().GetRaw("name"))
 }
 
-func (p *Name) Select1() SameName {
-	option := p.GetString("select1")
-	i, ok := zzSameNameSelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
+// The record field names of the User proxy
+const (
+	UserFieldId              ProxyField[User, string] = "id"
+	UserFieldPassword        ProxyField[User, string] = "password"
+	UserFieldTokenKey        ProxyField[User, string] = "tokenKey"
+	UserFieldEmail           ProxyField[User, string] = "email"
+	UserFieldEmailVisibility ProxyField[User, bool]   = "emailVisibility"
+	UserFieldVerified        ProxyField[User, bool]   = "verified"
+	UserFieldName            ProxyField[User, string] = "name"
+)
+
+// Returns the original and the current value of a field
+func (p *User) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(UserFieldName):
+		return p.OriginalName(), p.Name()
 	}
-	return i
+	return nil, nil
 }
 
-func (p *Name) SetSelect1(select1 SameName) {
-	i, ok := zzSameNameSelectIotaMap[select1]
-	if !ok {
-		panic("Unknown select value")
+// Returns the fields whose values differ from the original record
+func (p *User) ChangedFields() []string {
+	var fields []string
+	if p.NameChanged() {
+		fields = append(fields, string(UserFieldName))
 	}
-	p.Set("select1", i)
+	return fields
 }
 
-func (p *Name) Select2() SameName {
-	option := p.GetString("select2")
-	i, ok := zzSameNameSelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
-	}
-	return i
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "users", zzUserSchema))
+	return errors.Join(errs...)
 }
 
-func (p *Name) SetSelect2(select2 SameName) {
-	i, ok := zzSameNameSelectIotaMap[select2]
-	if !ok {
-		panic("Unknown select value")
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
 	}
-	p.Set("select2", i)
 }
-`
 
-	equal, err := expectGenerated(template, expectedGeneration)
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
 	if err != nil {
-		t.Fatalf("Error during generation: %v", err)
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
 	}
-	if !equal {
-		t.Fatal("the identical select types did not have the expected generation result")
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
 	}
+	return errors.Join(errs...)
 }
 
-func TestUnknownType(t *testing.T) {
-	template := `type Name struct {
-		illegal float32
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
 	}
-	`
-
-	template = addBoilerplate(template)
-	parser, _ := NewTemplateParser([]byte(template))
-	_, err := Generate(parser, ".", "test")
-	if err == nil {
-		t.Fatal("the illegally typed field did not cause the generation to error")
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
 	}
-}
-
-func TestIllegalSelectFieldType(t *testing.T) {
-	// Only int or []int is allowed for select types
-	template := `type Name struct {
-		// select: IllegalSelect(optA, optB)
-		illegal string
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
 	}
-	`
-
-	template = addBoilerplate(template)
-	_, err := NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the illegally typed select type field did not cause the generation to error")
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
 	}
+	return nil
 }
 
-func TestUncommentedSystemField(t *testing.T) {
-	template := `type Name struct {
-	Id string
+// The expected schema of the users collection
+var zzUserSchema = []proxySchemaField{
+	{
+		name: "name",
+		types: []string{
+			"text", "email", "url", "editor", "password", "select", "file", "json",
+		},
+	},
 }
 `
-	template = addBoilerplate(template)
-	parser, _ := NewTemplateParser([]byte(template))
-	_, err := Generate(parser, ".", "test")
-	if err == nil {
-		t.Fatal("the uncommented system field did not cause the generation to error")
+
+	equal, err := expectGenerated(template, expectedGeneration)
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	if !equal {
+		t.Fatal("the auth collection did not have the expected generation result")
 	}
 }
 
-func TestMalformedSelectComment(t *testing.T) {
+func TestUnknownCollectionType(t *testing.T) {
 	template := `type Name struct {
-	// select: (optA, optB)
-	selectVar int
+	// collection-name: names
+	// collection-type: graph
+	Id string
 }
 `
 	template = addBoilerplate(template)
 	_, err := NewTemplateParser([]byte(template))
 	if err == nil {
-		t.Fatal("the malformed select comment did not cause the generation to error")
+		t.Fatal("the unknown collection type did not cause the generation to error")
 	}
-
-	template = `type Name struct {
-		// select: SelectTypeName()
-		selectVar int
 }
-`
-	template = addBoilerplate(template)
-	_, err = NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the malformed select comment did not cause the generation to error")
-	}
 
-	template = `type Name struct {
-		// select: SelectTypeName(optA, optB)[nameA, nameB, nameC]
-		selectVar int
-}
-`
-	template = addBoilerplate(template)
-	_, err = NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the malformed select comment did not cause the generation to error")
-	}
+func TestViewCollection(t *testing.T) {
+	template := `type PostStats struct {
+	// collection-name: post_stats
+	// collection-type: view
+	// view-query: SELECT id, title, cover FROM posts
+	// system: id
+	Id    string
+	title string
+	// file:
+	cover string
 }
 
-func TestIllegalMultiNameFields(t *testing.T) {
-	template := `type Name struct {
-	select1, func_ int
+func (s *PostStats) Summary() string {
+	return s.title + " (" + s.cover + ")"
 }
 `
 
-	template = addBoilerplate(template)
-	_, err := NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the illegal multi name field with an underscore escape did not cause the generation to error")
-	}
-
-	template = `type Name struct {
-		// system: both!
-		name1, name2 int
+	expectedGeneration := `type PostStats struct {
+	core.BaseRecordProxy
 }
-`
 
-	template = addBoilerplate(template)
-	_, err = NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the illegal multi name system field did not cause the generation to error")
-	}
+func (s *PostStats) Summary() string {
+	return s.Title() + " (" + s.Cover() + ")"
+}
 
-	template = `type Name struct {
-		// select: SelectTypeName(a, b)
-		name1, name2 int
+func (p *PostStats) CollectionName() string {
+	return "post_stats"
 }
-`
 
-	template = addBoilerplate(template)
-	_, err = NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the illegal multi name select type field did not cause the generation to error")
-	}
+func (p *PostStats) Title() string {
+	return p.GetString("title")
+}
 
-	template = `type Name struct {
-		// schema-name: both!
-		name1, name2 int
+func (p *PostStats) Cover() string {
+	return p.GetString("cover")
 }
-`
 
-	template = addBoilerplate(template)
-	_, err = NewTemplateParser([]byte(template))
-	if err == nil {
-		t.Fatal("the illegal multi name renamed field did not cause the generation to error")
+func (p *PostStats) CoverURL() string {
+	name := p.GetString("cover")
+	if name == "" {
+		return ""
 	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name
 }
 
-func TestDuplicateSelectOption(t *testing.T) {
-	template := `type Name struct {
-	// select: SelectTypeName2(sameName)
-	select1 int
-	// select: SelectTypeName1(sameName)
-	select2 int
+func (p *PostStats) CoverThumbURL(thumb string) string {
+	name := p.GetString("cover")
+	if name == "" {
+		return ""
+	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name + "?thumb=" + thumb
 }
-`
-
-	expectedGeneration := `type SelectTypeName2 int
 
-const SameName SelectTypeName2 = iota
-
-var zzSelectTypeName2SelectNameMap = map[string]SelectTypeName2{"sameName": 0}
-var zzSelectTypeName2SelectIotaMap = map[SelectTypeName2]string{0: "sameName"}
-
-type SelectTypeName1 int
-
-const SameName2 SelectTypeName1 = iota
+// The record field names of the PostStats proxy
+const (
+	PostStatsFieldId    ProxyField[PostStats, string] = "id"
+	PostStatsFieldTitle ProxyField[PostStats, string] = "title"
+	PostStatsFieldCover ProxyField[PostStats, string] = "cover"
+)
 
-var zzSelectTypeName1SelectNameMap = map[string]SelectTypeName1{"sameName": 0}
-var zzSelectTypeName1SelectIotaMap = map[SelectTypeName1]string{0: "sameName"}
+// Checks that the collections of the app still match the generated proxies
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "post_stats", zzPostStatsSchema))
+	return errors.Join(errs...)
+}
 
-type Name struct {
-	core.BaseRecordProxy
+// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
 }
 
-func (p *Name) Select1() SelectTypeName2 {
-	option := p.GetString("select1")
-	i, ok := zzSelectTypeName2SelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
 	}
-	return i
 }
 
-func (p *Name) SetSelect1(select1 SelectTypeName2) {
-	i, ok := zzSelectTypeName2SelectIotaMap[select1]
-	if !ok {
-		panic("Unknown select value")
-	}
-	p.Set("select1", i)
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
 }
 
-func (p *Name) Select2() SelectTypeName1 {
-	option := p.GetString("select2")
-	i, ok := zzSelectTypeName1SelectNameMap[option]
-	if !ok {
-		panic("Unknown select value")
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
 	}
-	return i
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
 }
 
-func (p *Name) SetSelect2(select2 SelectTypeName1) {
-	i, ok := zzSelectTypeName1SelectIotaMap[select2]
-	if !ok {
-		panic("Unknown select value")
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
 	}
-	p.Set("select2", i)
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// The expected schema of the post_stats collection
+var zzPostStatsSchema = []proxySchemaField{
+	{
+		name: "title",
+		types: []string{
+			"text", "email", "url", "editor", "password", "select", "file", "json",
+		},
+	},
+	{
+		name:  "cover",
+		types: []string{"file"},
+	},
 }
 `
 
@@ -766,40 +5643,35 @@ func (p *Name) SetSelect2(select2 SelectTypeName1) {
 		t.Fatalf("Error during generation: %v", err)
 	}
 	if !equal {
-		t.Fatal("the duplicate select option names did not have the expected generation result")
+		t.Fatal("the view collection did not have the expected generation result")
 	}
 }
 
-func TestCollectionNameGetter(t *testing.T) {
-	template := `type Name struct {
-	// collection-name: collection_name
-	firstField string
-}
-`
-
-	expectedGeneration := `type Name struct {
-	core.BaseRecordProxy
-}
-
-func (p *Name) CollectionName() string {
-	return "collection_name"
-}
-
-func (p *Name) FirstField() string {
-	return p.GetString("firstField")
+func TestViewFieldAssignment(t *testing.T) {
+	template := `type PostStats struct {
+	// collection-name: post_stats
+	// collection-type: view
+	// system: id
+	Id    string
+	title string
 }
 
-func (p *Name) SetFirstField(firstField string) {
-	p.Set("firstField", firstField)
+func (s *PostStats) Rename(title string) {
+	s.title = title
 }
 `
-
-	equal, err := expectGenerated(template, expectedGeneration)
+	template = addBoilerplate(template)
+	parser, err := NewTemplateParser([]byte(template))
 	if err != nil {
-		t.Fatalf("Error during generation: %v", err)
+		t.Fatalf("Error during parsing: %v", err)
 	}
-	if !equal {
-		t.Fatal("the collection name comment did not have the expected generation result")
+	_, err = Generate(parser, ".", "test")
+	if err == nil {
+		t.Fatal("the assignment to a view collection field did not cause the generation to error")
+	}
+	expectedMsg := "Can not assign to the field `title` because `PostStats` is a view collection."
+	if !strings.Contains(err.Error(), expectedMsg) {
+		t.Fatalf("the assignment to a view collection field caused an unexpected error: %v", err)
 	}
 }
 
@@ -1,6 +1,11 @@
 package generator_test
 
 import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	. "github.com/nedieyassin/pocketbase-gogen/generator"
@@ -16,7 +21,8 @@ type ProxyRecordEvent[P Proxy, PP ProxyP[P]] struct {
 	App core.App
 	baseProxyEventData[P, PP]
 	Context context.Context
-	Type    string
+	// create, update, delete or validate
+	Type string
 }
 
 type ProxyRecordErrorEvent[P Proxy, PP ProxyP[P]] struct {
@@ -106,37 +112,60 @@ func newProxyEnrichEventFromRecordEnrichEvent[P Proxy, PP ProxyP[P]](re *core.Re
 }
 
 func syncProxyRequestEventWithRecordRequestEvent[P Proxy, PP ProxyP[P]](pe *ProxyRecordRequestEvent[P, PP], re *core.RecordRequestEvent) {
-	pe.App = re.App
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
 }
 
 func syncRecordRequestEventWithProxyRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordRequestEvent, pe *ProxyRecordRequestEvent[P, PP]) {
-	re.App = pe.App
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
 }
 
 func newProxyRequestEventFromRecordRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordRequestEvent) *ProxyRecordRequestEvent[P, PP] {
 	pe := &ProxyRecordRequestEvent[P, PP]{}
-	pe.RequestEvent = re.RequestEvent
-	pe.Collection = re.Collection
-	pe.PRecord, _ = WrapRecord[P, PP](re.Record)
+	syncProxyRequestEventWithRecordRequestEvent(pe, re)
 	return pe
 }
 
 func syncProxyListRequestEventWithRecordListRequestEvent[P Proxy, PP ProxyP[P]](pe *ProxyRecordsListRequestEvent[P, PP], re *core.RecordsListRequestEvent) {
-	pe.App = re.App
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecords = make([]PP, len(re.Records))
+	for i, r := range re.Records {
+		pe.PRecords[i] = wrapEventRecord[P, PP](r)
+	}
+	pe.Result = re.Result
 }
 
 func syncRecordListRequestEventWithProxyListRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordsListRequestEvent, pe *ProxyRecordsListRequestEvent[P, PP]) {
-	re.App = pe.App
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Records = make([]*core.Record, len(pe.PRecords))
+	for i, p := range pe.PRecords {
+		re.Records[i] = unwrapEventRecord[P, PP](p)
+	}
+	re.Result = pe.Result
+	syncListResultItems(re.Result, re.Records)
+}
+
+// The list result holds the same records as the event so a
+// replaced proxy list has to reach the response as well.
+// Items that were replaced by something other than records are kept.
+func syncListResultItems(result *search.Result, records []*core.Record) {
+	if result == nil {
+		return
+	}
+	switch result.Items.(type) {
+	case []*core.Record, *[]*core.Record:
+		result.Items = records
+	}
 }
 
 func newProxyListRequestEventFromRecordListRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordsListRequestEvent) *ProxyRecordsListRequestEvent[P, PP] {
 	pe := &ProxyRecordsListRequestEvent[P, PP]{}
-	pe.RequestEvent = re.RequestEvent
-	pe.Collection = re.Collection
-	pe.PRecords = make([]PP, len(re.Records))
-	for i, r := range re.Records {
-		pe.PRecords[i], _ = WrapRecord[P, PP](r)
-	}
+	syncProxyListRequestEventWithRecordListRequestEvent(pe, re)
 	return pe
 }
 
@@ -234,6 +263,360 @@ func registerProxyRequestEventHook[P Proxy, PP ProxyP[P]](recordHook *hook.Tagge
 	},
 	)
 }
+
+// Wraps the record of an event. Not every event
+// carries a record so nil is passed through.
+func wrapEventRecord[P Proxy, PP ProxyP[P]](record *core.Record) PP {
+	if record == nil {
+		return nil
+	}
+	p, _ := WrapRecord[P, PP](record)
+	return p
+}
+
+func unwrapEventRecord[P Proxy, PP ProxyP[P]](p PP) *core.Record {
+	if p == nil {
+		return nil
+	}
+	return p.ProxyRecord()
+}
+
+// Implemented by the proxy versions of the auth request
+// events to sync them with their PocketBase counterpart
+type proxyAuthEvent[E hook.Tagger, PE any] interface {
+	*PE
+	hook.Resolver
+	syncWithRecordEvent(re E)
+	syncRecordEvent(re E)
+}
+
+func registerProxyAuthEventHook[E hook.Tagger, PE any, PEP proxyAuthEvent[E, PE]](recordHook *hook.TaggedHook[E], proxyHook *hook.Hook[PEP]) {
+	recordHook.Bind(&hook.Handler[E]{
+		Func: func(re E) error {
+			var pe PEP = new(PE)
+			pe.syncWithRecordEvent(re)
+			err := proxyHook.Trigger(pe, func(pe PEP) error {
+				pe.syncRecordEvent(re)
+				defer pe.syncWithRecordEvent(re)
+				return re.Next()
+			},
+			)
+			pe.syncRecordEvent(re)
+			return err
+		},
+
+		Priority: -99,
+	},
+	)
+}
+
+type ProxyRecordAuthRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	Token      string
+	Meta       any
+	AuthMethod string
+}
+
+func (pe *ProxyRecordAuthRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.Token = re.Token
+	pe.Meta = re.Meta
+	pe.AuthMethod = re.AuthMethod
+}
+
+func (pe *ProxyRecordAuthRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.Token = pe.Token
+	re.Meta = pe.Meta
+	re.AuthMethod = pe.AuthMethod
+}
+
+type ProxyRecordAuthWithPasswordRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	Identity      string
+	IdentityField string
+	Password      string
+}
+
+func (pe *ProxyRecordAuthWithPasswordRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthWithPasswordRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.Identity = re.Identity
+	pe.IdentityField = re.IdentityField
+	pe.Password = re.Password
+}
+
+func (pe *ProxyRecordAuthWithPasswordRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthWithPasswordRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.Identity = pe.Identity
+	re.IdentityField = pe.IdentityField
+	re.Password = pe.Password
+}
+
+type ProxyRecordAuthWithOAuth2RequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	ProviderName   string
+	ProviderClient auth.Provider
+	OAuth2User     *auth.AuthUser
+	CreateData     map[string]any
+	IsNewRecord    bool
+}
+
+func (pe *ProxyRecordAuthWithOAuth2RequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthWithOAuth2RequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.ProviderName = re.ProviderName
+	pe.ProviderClient = re.ProviderClient
+	pe.OAuth2User = re.OAuth2User
+	pe.CreateData = re.CreateData
+	pe.IsNewRecord = re.IsNewRecord
+}
+
+func (pe *ProxyRecordAuthWithOAuth2RequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthWithOAuth2RequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.ProviderName = pe.ProviderName
+	re.ProviderClient = pe.ProviderClient
+	re.OAuth2User = pe.OAuth2User
+	re.CreateData = pe.CreateData
+	re.IsNewRecord = pe.IsNewRecord
+}
+
+type ProxyRecordAuthRefreshRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordAuthRefreshRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthRefreshRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordAuthRefreshRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthRefreshRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordRequestPasswordResetRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordRequestPasswordResetRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordRequestPasswordResetRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordRequestPasswordResetRequestEvent[P, PP]) syncRecordEvent(re *core.RecordRequestPasswordResetRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordConfirmPasswordResetRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordConfirmPasswordResetRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordConfirmPasswordResetRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordConfirmPasswordResetRequestEvent[P, PP]) syncRecordEvent(re *core.RecordConfirmPasswordResetRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordRequestVerificationRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordRequestVerificationRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordRequestVerificationRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordRequestVerificationRequestEvent[P, PP]) syncRecordEvent(re *core.RecordRequestVerificationRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordConfirmVerificationRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordConfirmVerificationRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordConfirmVerificationRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordConfirmVerificationRequestEvent[P, PP]) syncRecordEvent(re *core.RecordConfirmVerificationRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordRequestEmailChangeRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	NewEmail string
+}
+
+func (pe *ProxyRecordRequestEmailChangeRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordRequestEmailChangeRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.NewEmail = re.NewEmail
+}
+
+func (pe *ProxyRecordRequestEmailChangeRequestEvent[P, PP]) syncRecordEvent(re *core.RecordRequestEmailChangeRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.NewEmail = pe.NewEmail
+}
+
+type ProxyRecordConfirmEmailChangeRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	NewEmail string
+}
+
+func (pe *ProxyRecordConfirmEmailChangeRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordConfirmEmailChangeRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.NewEmail = re.NewEmail
+}
+
+func (pe *ProxyRecordConfirmEmailChangeRequestEvent[P, PP]) syncRecordEvent(re *core.RecordConfirmEmailChangeRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.NewEmail = pe.NewEmail
+}
+
+type ProxyRecordRequestOTPRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	Password string
+}
+
+func (pe *ProxyRecordRequestOTPRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordCreateOTPRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.Password = re.Password
+}
+
+func (pe *ProxyRecordRequestOTPRequestEvent[P, PP]) syncRecordEvent(re *core.RecordCreateOTPRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.Password = pe.Password
+}
+
+type ProxyRecordAuthWithOTPRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	OTP *core.OTP
+}
+
+func (pe *ProxyRecordAuthWithOTPRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthWithOTPRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.OTP = re.OTP
+}
+
+func (pe *ProxyRecordAuthWithOTPRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthWithOTPRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.OTP = pe.OTP
+}
+
+// Implemented by the field name constants of the proxy P
+type proxyField[P Proxy] interface {
+	key() string
+	fieldOf(P)
+}
+
+// The hook of the record update events of a proxy. It can
+// bind handlers that only run when a field changed.
+type ProxyUpdateHook[P Proxy, PP ProxyP[P]] struct {
+	hook.Hook[*ProxyRecordEvent[P, PP]]
+}
+
+// Binds a handler that is only called when the given field
+// changed. Otherwise the event just continues. The field
+// name constant returns the typed original and new value.
+//
+// Usage with an exemplary User proxy that has a name field:
+//
+//	pHooks.OnUserUpdate.WhenChanged(UserFieldName, func(e *UserEvent) error {
+//		oldName, newName := UserFieldName.Values(e.PRecord)
+//		fmt.Printf("%v is now called %v", oldName, newName)
+//		return e.Next()
+//	})
+func (h *ProxyUpdateHook[P, PP]) WhenChanged(field proxyField[P], handler func(e *ProxyRecordEvent[P, PP]) error) string {
+	key := field.key()
+	return h.BindFunc(func(e *ProxyRecordEvent[P, PP]) error {
+		record := e.PRecord.ProxyRecord()
+		if reflect.DeepEqual(record.GetRaw(key), record.Original().GetRaw(key)) {
+			return e.Next()
+		}
+		return handler(e)
+	},
+	)
+}
 `
 
 	if !expectGeneratedEvents(expectedGeneration) {
@@ -251,3 +634,355 @@ func expectGeneratedEvents(expectedOutput string) bool {
 
 	return output == expectedOutput
 }
+
+// Generates the proxies with events and hooks into a temporary module
+// and runs its tests against a PocketBase test app
+func TestProxyEventsWithTestApp(t *testing.T) {
+	template := addBoilerplate(`type Post struct {
+	// collection-name: posts
+	// system: id
+	id string
+	title string
+}
+`)
+
+	runGeneratedTests(t, template, proxyEventsAppTest)
+}
+
+// Generates the proxies, utils, events and hooks of the template
+// into a module in a temporary directory and runs the test source
+// against them. The module copies the requirements of this module
+// so that the generated code builds without touching its go.mod.
+func runGeneratedTests(t *testing.T, template, testSource string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	goMod, err := os.ReadFile("../go.mod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod = regexp.MustCompile(`(?m)^module .*$`).ReplaceAll(goMod, []byte("module generatedtest"))
+	goSum, err := os.ReadFile("../go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		"go.mod":            goMod,
+		"go.sum":            goSum,
+		"generated_test.go": []byte(testSource),
+	}
+
+	parser, err := NewTemplateParser([]byte(template))
+	if err != nil {
+		t.Fatal(err)
+	}
+	generators := map[string]func(savePath string) ([]byte, error){
+		"proxies.go": func(savePath string) ([]byte, error) { return Generate(parser, savePath, "generatedtest") },
+		"utils.go":   func(savePath string) ([]byte, error) { return GenerateUtils(parser, savePath, "generatedtest") },
+		"events.go":  func(savePath string) ([]byte, error) { return GenerateProxyEvents(savePath, "generatedtest") },
+		"hooks.go":   func(savePath string) ([]byte, error) { return GenerateProxyHooks(parser, savePath, "generatedtest") },
+	}
+	for file, generate := range generators {
+		sourceCode, err := generate(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatalf("could not generate %v: %v", file, err)
+		}
+		files[file] = sourceCode
+	}
+	for file, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command("go", "test", "-count=1", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("the generated code did not pass the tests:\n%s", out)
+	}
+}
+
+func TestWhenChangedTypes(t *testing.T) {
+	template := addBoilerplate(`type Post struct {
+	// collection-name: posts
+	// system: id
+	id string
+	title string
+}
+
+type Comment struct {
+	// collection-name: comments
+	// system: id
+	id string
+	likes int
+}
+`)
+	parser, err := NewTemplateParser([]byte(template))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxies, err := Generate(parser, ".", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	utils, err := GenerateUtils(parser, ".", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := GenerateProxyEvents(".", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hooks, err := GenerateProxyHooks(parser, ".", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlers := map[string]bool{
+		`pHooks.OnPostUpdate.WhenChanged(PostFieldTitle, func(e *PostEvent) error {
+		var _, _ string = PostFieldTitle.Values(e.PRecord)
+		return nil
+	})`: true,
+		`pHooks.OnPostUpdate.WhenChanged(PostFieldTitle, func(e *PostEvent) error {
+		var _, _ int = PostFieldTitle.Values(e.PRecord)
+		return nil
+	})`: false,
+		`pHooks.OnPostUpdate.WhenChanged(CommentFieldLikes, func(e *PostEvent) error {
+		return nil
+	})`: false,
+	}
+	for handler, compiles := range handlers {
+		source := fmt.Sprintf("package test\n\nfunc bind(pHooks *ProxyHooks) {\n\t%v\n}\n", handler)
+		err := typeCheck(proxies, utils, events, hooks, []byte(source))
+		if compiles && err != nil {
+			t.Fatalf("the handler did not compile: %v\n%v", err, handler)
+		}
+		if !compiles && err == nil {
+			t.Fatalf("the handler compiled even though the field or its value type is wrong:\n%v", handler)
+		}
+	}
+}
+
+const proxyEventsAppTest = `package generatedtest
+
+import (
+	"testing"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/hook"
+	"github.com/pocketbase/pocketbase/tools/search"
+	"github.com/pocketbase/pocketbase/tests"
+)
+
+func newTestApp(t *testing.T) *tests.TestApp {
+	app, err := tests.NewTestApp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection := core.NewBaseCollection("posts")
+	collection.Fields.Add(&core.TextField{Name: "title"})
+	if err := app.Save(collection); err != nil {
+		t.Fatal(err)
+	}
+	return app
+}
+
+func newPost(t *testing.T, app core.App, title string) *Post {
+	post, err := NewProxy[Post](app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	post.SetTitle(title)
+	if err := app.Save(post); err != nil {
+		t.Fatal(err)
+	}
+	return post
+}
+
+func newListRequestEvent(app core.App, posts ...*Post) *core.RecordsListRequestEvent {
+	records := make([]*core.Record, len(posts))
+	for i, p := range posts {
+		records[i] = p.Record
+	}
+	e := &core.RecordsListRequestEvent{
+		RequestEvent: &core.RequestEvent{App: app},
+		Records:      records,
+		Result:       &search.Result{Items: &records, TotalItems: len(records)},
+	}
+	e.Collection = posts[0].Collection()
+	return e
+}
+
+func newRequestEvent(app core.App, post *Post) *core.RecordRequestEvent {
+	e := &core.RecordRequestEvent{
+		RequestEvent: &core.RequestEvent{App: app},
+		Record:       post.Record,
+	}
+	e.Collection = post.Collection()
+	return e
+}
+
+func TestListRequestProxyChangesReachRecordEvent(t *testing.T) {
+	app := newTestApp(t)
+	defer app.Cleanup()
+	a, b := newPost(t, app, "a"), newPost(t, app, "b")
+
+	pHooks := NewProxyHooks(app)
+	pHooks.OnPostListRequest.BindFunc(func(e *PostListRequestEvent) error {
+		e.PRecords = e.PRecords[1:]
+		e.Result.TotalItems = len(e.PRecords)
+		return e.Next()
+	})
+
+	event := newListRequestEvent(app, a, b)
+	err := app.OnRecordsListRequest().Trigger(event, func(e *core.RecordsListRequestEvent) error {
+		if len(e.Records) != 1 || e.Records[0].Id != b.Id {
+			t.Errorf("the record event did not receive the proxy list: %v", e.Records)
+		}
+		items, ok := e.Result.Items.([]*core.Record)
+		if !ok || len(items) != 1 || items[0].Id != b.Id {
+			t.Errorf("the result items were not synced with the proxy list: %v", e.Result.Items)
+		}
+		if e.Result.TotalItems != 1 {
+			t.Errorf("the result change did not reach the record event: %v", e.Result.TotalItems)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListRequestRecordChangesReachProxyEvent(t *testing.T) {
+	app := newTestApp(t)
+	defer app.Cleanup()
+	a, b, c := newPost(t, app, "a"), newPost(t, app, "b"), newPost(t, app, "c")
+
+	app.OnRecordsListRequest("posts").Bind(&hook.Handler[*core.RecordsListRequestEvent]{
+		Func: func(e *core.RecordsListRequestEvent) error {
+			e.Records = e.Records[:1]
+			return e.Next()
+		},
+		Priority: -100,
+	})
+	app.OnRecordsListRequest("posts").BindFunc(func(e *core.RecordsListRequestEvent) error {
+		e.Records = append(e.Records, c.Record)
+		return e.Next()
+	})
+
+	pHooks := NewProxyHooks(app)
+	pHooks.OnPostListRequest.BindFunc(func(e *PostListRequestEvent) error {
+		if len(e.PRecords) != 1 || e.PRecords[0].Id != a.Id {
+			t.Errorf("the proxy event did not receive the earlier record change: %v", e.PRecords)
+		}
+		if err := e.Next(); err != nil {
+			return err
+		}
+		if len(e.PRecords) != 2 || e.PRecords[1].Title() != "c" {
+			t.Errorf("the proxy event did not receive the later record change: %v", e.PRecords)
+		}
+		return nil
+	})
+
+	event := newListRequestEvent(app, a, b)
+	err := app.OnRecordsListRequest().Trigger(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRequestProxyChangesReachRecordEvent(t *testing.T) {
+	app := newTestApp(t)
+	defer app.Cleanup()
+	a, b := newPost(t, app, "a"), newPost(t, app, "b")
+
+	pHooks := NewProxyHooks(app)
+	pHooks.OnPostViewRequest.BindFunc(func(e *PostRequestEvent) error {
+		e.PRecord = b
+		return e.Next()
+	})
+
+	event := newRequestEvent(app, a)
+	err := app.OnRecordViewRequest().Trigger(event, func(e *core.RecordRequestEvent) error {
+		if e.Record != b.Record {
+			t.Errorf("the record event did not receive the replaced proxy: %v", e.Record)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Record != b.Record {
+		t.Errorf("the replaced proxy did not outlive the hook: %v", event.Record)
+	}
+}
+
+func TestRequestRecordChangesReachProxyEvent(t *testing.T) {
+	app := newTestApp(t)
+	defer app.Cleanup()
+	a, b, c := newPost(t, app, "a"), newPost(t, app, "b"), newPost(t, app, "c")
+
+	app.OnRecordViewRequest("posts").Bind(&hook.Handler[*core.RecordRequestEvent]{
+		Func: func(e *core.RecordRequestEvent) error {
+			e.Record = b.Record
+			return e.Next()
+		},
+		Priority: -100,
+	})
+	app.OnRecordViewRequest("posts").BindFunc(func(e *core.RecordRequestEvent) error {
+		e.Record = c.Record
+		return e.Next()
+	})
+
+	pHooks := NewProxyHooks(app)
+	pHooks.OnPostViewRequest.BindFunc(func(e *PostRequestEvent) error {
+		if e.PRecord.Id != b.Id {
+			t.Errorf("the proxy event did not receive the earlier record change: %v", e.PRecord.Id)
+		}
+		if err := e.Next(); err != nil {
+			return err
+		}
+		if e.PRecord.Title() != "c" {
+			t.Errorf("the proxy event did not receive the later record change: %v", e.PRecord.Id)
+		}
+		return nil
+	})
+
+	event := newRequestEvent(app, a)
+	err := app.OnRecordViewRequest().Trigger(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWhenChanged(t *testing.T) {
+	app := newTestApp(t)
+	defer app.Cleanup()
+	id := newPost(t, app, "a").Id
+
+	var changes []string
+	pHooks := NewProxyHooks(app)
+	pHooks.OnPostUpdate.WhenChanged(PostFieldTitle, func(e *PostEvent) error {
+		oldTitle, newTitle := PostFieldTitle.Values(e.PRecord)
+		changes = append(changes, oldTitle+" -> "+newTitle)
+		return e.Next()
+	})
+
+	// The original values are the ones that were loaded from the db
+	for _, title := range []string{"b", "b"} {
+		post, err := FindById[Post](app, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.SetTitle(title)
+		if err := app.Save(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(changes) != 1 || changes[0] != "a -> b" {
+		t.Errorf("the handler did not run exactly once for the changed title: %v", changes)
+	}
+}
+`
@@ -3,11 +3,19 @@ package generator
 import (
 	"go/ast"
 
+	"github.com/pocketbase/pocketbase/core"
 	"github.com/snonky/astpos/astpos"
 )
 
 func GenerateProxyHooks(templateParser *Parser, savePath, packageName string) ([]byte, error) {
-	decls := hooksFromTemplate(templateParser)
+	if err := loadPBInfo(); err != nil {
+		return nil, err
+	}
+
+	decls, err := hooksFromTemplate(templateParser)
+	if err != nil {
+		return nil, err
+	}
 
 	f := wrapGeneratedDeclarations(decls, packageName)
 
@@ -20,27 +28,30 @@ func GenerateProxyHooks(templateParser *Parser, savePath, packageName string) ([
 	return sourceCode, nil
 }
 
-func hooksFromTemplate(parser *Parser) []ast.Decl {
+func hooksFromTemplate(parser *Parser) ([]ast.Decl, error) {
 	decls := make([]ast.Decl, 0)
 	structNames := make([]string, 0, len(parser.structSpecs))
-	collectionNames := parser.collectionNames
+	collectionRefs := make(map[string]string)
 	for _, s := range parser.structSpecs {
 		structName := s.Name.Name
-		_, ok := collectionNames[structName]
+		_, ok := parser.collectionNames[structName]
 		if ok {
 			structNames = append(structNames, structName)
+			collectionRefs[structName] = parser.collectionRef(structName)
 		}
 	}
 
-	decls = append(decls, createEventAliases(structNames)...)
-	decls = append(decls, createProxyHooksStruct(structNames))
-	decls = append(decls, createProxyHooksConstructor(structNames))
-	decls = append(decls, createProxyHooksRegistrationFunc(structNames, collectionNames))
+	collectionTypes := parser.collectionTypes
 
-	return decls
+	decls = append(decls, createEventAliases(structNames, collectionTypes)...)
+	decls = append(decls, createProxyHooksStruct(structNames, collectionTypes))
+	decls = append(decls, createProxyHooksConstructor(structNames, collectionTypes))
+	decls = append(decls, createProxyHooksRegistrationFunc(structNames, collectionRefs, collectionTypes))
+
+	return decls, nil
 }
 
-func createEventAliases(structNames []string) []ast.Decl {
+func createEventAliases(structNames []string, collectionTypes map[string]string) []ast.Decl {
 	decls := make([]ast.Decl, 0, len(structNames)*5)
 	for _, structName := range structNames {
 		decls = append(decls,
@@ -49,12 +60,19 @@ func createEventAliases(structNames []string) []ast.Decl {
 			newEventTypeAliasDecl(proxyErrorEventAliasTemplate, structName),
 			newEventTypeAliasDecl(proxyListEventAliasTemplate, structName),
 			newEventTypeAliasDecl(proxyRequestEventAliasTemplate, structName),
+			newEventTypeAliasDecl(updateHookAliasTemplate, structName),
 		)
+		if collectionTypes[structName] != core.CollectionTypeAuth {
+			continue
+		}
+		for _, template := range authEventAliasTemplates {
+			decls = append(decls, newEventTypeAliasDecl(template, structName))
+		}
 	}
 	return decls
 }
 
-func createProxyHooksStruct(structNames []string) *ast.GenDecl {
+func createProxyHooksStruct(structNames []string, collectionTypes map[string]string) *ast.GenDecl {
 	structDecl := newProxyHooksStructDecl()
 	structType := structDecl.Specs[0].(*ast.TypeSpec).Type.(*ast.StructType)
 	fieldList := make([]*ast.Field, 0, len(structNames)*19)
@@ -62,10 +80,19 @@ func createProxyHooksStruct(structNames []string) *ast.GenDecl {
 	templateType := proxyHooksTemplate.Specs[0].(*ast.TypeSpec).Type.(*ast.StructType)
 	fieldTemplates := templateType.Fields.List
 
+	authTemplateType := proxyAuthHooksTemplate.Specs[0].(*ast.TypeSpec).Type.(*ast.StructType)
+	authFieldTemplates := authTemplateType.Fields.List
+
 	for _, structName := range structNames {
 		for _, template := range fieldTemplates {
 			fieldList = append(fieldList, newHookField(template, structName))
 		}
+		if collectionTypes[structName] != core.CollectionTypeAuth {
+			continue
+		}
+		for _, template := range authFieldTemplates {
+			fieldList = append(fieldList, newHookField(template, structName))
+		}
 	}
 
 	structType.Fields.List = fieldList
@@ -73,7 +100,7 @@ func createProxyHooksStruct(structNames []string) *ast.GenDecl {
 	return structDecl
 }
 
-func createProxyHooksConstructor(structNames []string) *ast.FuncDecl {
+func createProxyHooksConstructor(structNames []string, collectionTypes map[string]string) *ast.FuncDecl {
 	funcDecl := newProxyHooksConstructor()
 	assignStmt := funcDecl.Body.List[0].(*ast.AssignStmt)
 	structLit := assignStmt.Rhs[0].(*ast.UnaryExpr).X.(*ast.CompositeLit)
@@ -82,10 +109,19 @@ func createProxyHooksConstructor(structNames []string) *ast.FuncDecl {
 	templateAssign := proxyHooksConstructorTemplate.Body.List[0].(*ast.AssignStmt)
 	fieldTemplates := templateAssign.Rhs[0].(*ast.UnaryExpr).X.(*ast.CompositeLit).Elts
 
+	authTemplateAssign := proxyAuthHooksConstructorTemplate.Body.List[0].(*ast.AssignStmt)
+	authFieldTemplates := authTemplateAssign.Rhs[0].(*ast.UnaryExpr).X.(*ast.CompositeLit).Elts
+
 	for _, structName := range structNames {
 		for _, template := range fieldTemplates {
 			structFieldInits = append(structFieldInits, newHookConstructorArgument(template.(*ast.KeyValueExpr), structName))
 		}
+		if collectionTypes[structName] != core.CollectionTypeAuth {
+			continue
+		}
+		for _, template := range authFieldTemplates {
+			structFieldInits = append(structFieldInits, newHookConstructorArgument(template.(*ast.KeyValueExpr), structName))
+		}
 	}
 
 	structLit.Elts = structFieldInits
@@ -93,16 +129,24 @@ func createProxyHooksConstructor(structNames []string) *ast.FuncDecl {
 	return funcDecl
 }
 
-func createProxyHooksRegistrationFunc(structNames []string, collectionNames map[string]string) *ast.FuncDecl {
+// The collection refs are the names or ids that the hooks are tagged with
+func createProxyHooksRegistrationFunc(structNames []string, collectionRefs, collectionTypes map[string]string) *ast.FuncDecl {
 	funcDecl := newHookRegistrationFuncDecl()
 	callExprList := make([]ast.Stmt, 0, len(structNames)*19)
 
 	callTemplates := proxyHookRegistrationTemplate.Body.List
+	authCallTemplates := proxyAuthHookRegistrationTemplate.Body.List
 
 	for _, structName := range structNames {
-		collectionName := collectionNames[structName]
+		collectionRef := collectionRefs[structName]
 		for _, template := range callTemplates {
-			callExprList = append(callExprList, newHookRegistrationCallExpr(template.(*ast.ExprStmt), structName, collectionName))
+			callExprList = append(callExprList, newHookRegistrationCallExpr(template.(*ast.ExprStmt), structName, collectionRef))
+		}
+		if collectionTypes[structName] != core.CollectionTypeAuth {
+			continue
+		}
+		for _, template := range authCallTemplates {
+			callExprList = append(callExprList, newHookRegistrationCallExpr(template.(*ast.ExprStmt), structName, collectionRef))
 		}
 	}
 
@@ -11,6 +11,8 @@ func TestProxyHooksGeneration(t *testing.T) {
 	// collection-name: collection_1
 	// system: id
 	id string
+	name string
+	parent *Proxy1
 }
 
 type NoCollectionNameProxy struct {
@@ -25,6 +27,7 @@ type Proxy1EnrichEvent = ProxyRecordEnrichEvent[Proxy1, *Proxy1]
 type Proxy1ErrorEvent = ProxyRecordErrorEvent[Proxy1, *Proxy1]
 type Proxy1ListRequestEvent = ProxyRecordsListRequestEvent[Proxy1, *Proxy1]
 type Proxy1RequestEvent = ProxyRecordRequestEvent[Proxy1, *Proxy1]
+type Proxy1UpdateHook = ProxyUpdateHook[Proxy1, *Proxy1]
 
 // This struct is a container for all proxy hooks.
 // Use NewProxyHooks(app core.App) to create it once.
@@ -35,9 +38,9 @@ type ProxyHooks struct {
 	OnProxy1CreateExecute      *hook.Hook[*Proxy1Event]
 	OnProxy1AfterCreateSuccess *hook.Hook[*Proxy1Event]
 	OnProxy1AfterCreateError   *hook.Hook[*Proxy1ErrorEvent]
-	OnProxy1Update             *hook.Hook[*Proxy1Event]
-	OnProxy1UpdateExecute      *hook.Hook[*Proxy1Event]
-	OnProxy1AfterUpdateSuccess *hook.Hook[*Proxy1Event]
+	OnProxy1Update             *Proxy1UpdateHook
+	OnProxy1UpdateExecute      *Proxy1UpdateHook
+	OnProxy1AfterUpdateSuccess *Proxy1UpdateHook
 	OnProxy1AfterUpdateError   *hook.Hook[*Proxy1ErrorEvent]
 	OnProxy1Delete             *hook.Hook[*Proxy1Event]
 	OnProxy1DeleteExecute      *hook.Hook[*Proxy1Event]
@@ -72,9 +75,9 @@ func NewProxyHooks(app core.App) *ProxyHooks {
 		OnProxy1CreateExecute:      &hook.Hook[*Proxy1Event]{},
 		OnProxy1AfterCreateSuccess: &hook.Hook[*Proxy1Event]{},
 		OnProxy1AfterCreateError:   &hook.Hook[*Proxy1ErrorEvent]{},
-		OnProxy1Update:             &hook.Hook[*Proxy1Event]{},
-		OnProxy1UpdateExecute:      &hook.Hook[*Proxy1Event]{},
-		OnProxy1AfterUpdateSuccess: &hook.Hook[*Proxy1Event]{},
+		OnProxy1Update:             &Proxy1UpdateHook{},
+		OnProxy1UpdateExecute:      &Proxy1UpdateHook{},
+		OnProxy1AfterUpdateSuccess: &Proxy1UpdateHook{},
 		OnProxy1AfterUpdateError:   &hook.Hook[*Proxy1ErrorEvent]{},
 		OnProxy1Delete:             &hook.Hook[*Proxy1Event]{},
 		OnProxy1DeleteExecute:      &hook.Hook[*Proxy1Event]{},
@@ -97,9 +100,9 @@ func (pHooks *ProxyHooks) registerProxyHooks(app core.App) {
 	registerProxyEventHook(app.OnRecordCreateExecute("collection_1"), pHooks.OnProxy1CreateExecute)
 	registerProxyEventHook(app.OnRecordAfterCreateSuccess("collection_1"), pHooks.OnProxy1AfterCreateSuccess)
 	registerProxyErrorEventHook(app.OnRecordAfterCreateError("collection_1"), pHooks.OnProxy1AfterCreateError)
-	registerProxyEventHook(app.OnRecordUpdate("collection_1"), pHooks.OnProxy1Update)
-	registerProxyEventHook(app.OnRecordUpdateExecute("collection_1"), pHooks.OnProxy1UpdateExecute)
-	registerProxyEventHook(app.OnRecordAfterUpdateSuccess("collection_1"), pHooks.OnProxy1AfterUpdateSuccess)
+	registerProxyEventHook(app.OnRecordUpdate("collection_1"), &pHooks.OnProxy1Update.Hook)
+	registerProxyEventHook(app.OnRecordUpdateExecute("collection_1"), &pHooks.OnProxy1UpdateExecute.Hook)
+	registerProxyEventHook(app.OnRecordAfterUpdateSuccess("collection_1"), &pHooks.OnProxy1AfterUpdateSuccess.Hook)
 	registerProxyErrorEventHook(app.OnRecordAfterUpdateError("collection_1"), pHooks.OnProxy1AfterUpdateError)
 	registerProxyEventHook(app.OnRecordDelete("collection_1"), pHooks.OnProxy1Delete)
 	registerProxyEventHook(app.OnRecordDeleteExecute("collection_1"), pHooks.OnProxy1DeleteExecute)
@@ -122,6 +125,176 @@ func (pHooks *ProxyHooks) registerProxyHooks(app core.App) {
 	}
 }
 
+func TestAuthProxyHooksGeneration(t *testing.T) {
+	template := `type User struct {
+	// collection-name: users
+	// collection-type: auth
+	// system: id
+	Id string
+	// system: password
+	password string
+	// system: tokenKey
+	tokenKey string
+	// system: email
+	email string
+	// system: emailVisibility
+	emailVisibility bool
+	// system: verified
+	verified bool
+}
+`
+
+	expectedGeneration := `type UserEvent = ProxyRecordEvent[User, *User]
+type UserEnrichEvent = ProxyRecordEnrichEvent[User, *User]
+type UserErrorEvent = ProxyRecordErrorEvent[User, *User]
+type UserListRequestEvent = ProxyRecordsListRequestEvent[User, *User]
+type UserRequestEvent = ProxyRecordRequestEvent[User, *User]
+type UserUpdateHook = ProxyUpdateHook[User, *User]
+type UserAuthRequestEvent = ProxyRecordAuthRequestEvent[User, *User]
+type UserAuthWithPasswordRequestEvent = ProxyRecordAuthWithPasswordRequestEvent[User, *User]
+type UserAuthWithOAuth2RequestEvent = ProxyRecordAuthWithOAuth2RequestEvent[User, *User]
+type UserAuthRefreshRequestEvent = ProxyRecordAuthRefreshRequestEvent[User, *User]
+type UserRequestPasswordResetRequestEvent = ProxyRecordRequestPasswordResetRequestEvent[User, *User]
+type UserConfirmPasswordResetRequestEvent = ProxyRecordConfirmPasswordResetRequestEvent[User, *User]
+type UserRequestVerificationRequestEvent = ProxyRecordRequestVerificationRequestEvent[User, *User]
+type UserConfirmVerificationRequestEvent = ProxyRecordConfirmVerificationRequestEvent[User, *User]
+type UserRequestEmailChangeRequestEvent = ProxyRecordRequestEmailChangeRequestEvent[User, *User]
+type UserConfirmEmailChangeRequestEvent = ProxyRecordConfirmEmailChangeRequestEvent[User, *User]
+type UserRequestOTPRequestEvent = ProxyRecordRequestOTPRequestEvent[User, *User]
+type UserAuthWithOTPRequestEvent = ProxyRecordAuthWithOTPRequestEvent[User, *User]
+
+// This struct is a container for all proxy hooks.
+// Use NewProxyHooks(app core.App) to create it once.
+type ProxyHooks struct {
+	OnUserEnrich                      *hook.Hook[*UserEnrichEvent]
+	OnUserValidate                    *hook.Hook[*UserEvent]
+	OnUserCreate                      *hook.Hook[*UserEvent]
+	OnUserCreateExecute               *hook.Hook[*UserEvent]
+	OnUserAfterCreateSuccess          *hook.Hook[*UserEvent]
+	OnUserAfterCreateError            *hook.Hook[*UserErrorEvent]
+	OnUserUpdate                      *UserUpdateHook
+	OnUserUpdateExecute               *UserUpdateHook
+	OnUserAfterUpdateSuccess          *UserUpdateHook
+	OnUserAfterUpdateError            *hook.Hook[*UserErrorEvent]
+	OnUserDelete                      *hook.Hook[*UserEvent]
+	OnUserDeleteExecute               *hook.Hook[*UserEvent]
+	OnUserAfterDeleteSuccess          *hook.Hook[*UserEvent]
+	OnUserAfterDeleteError            *hook.Hook[*UserErrorEvent]
+	OnUserListRequest                 *hook.Hook[*UserListRequestEvent]
+	OnUserViewRequest                 *hook.Hook[*UserRequestEvent]
+	OnUserCreateRequest               *hook.Hook[*UserRequestEvent]
+	OnUserUpdateRequest               *hook.Hook[*UserRequestEvent]
+	OnUserDeleteRequest               *hook.Hook[*UserRequestEvent]
+	OnUserAuthRequest                 *hook.Hook[*UserAuthRequestEvent]
+	OnUserAuthWithPasswordRequest     *hook.Hook[*UserAuthWithPasswordRequestEvent]
+	OnUserAuthWithOAuth2Request       *hook.Hook[*UserAuthWithOAuth2RequestEvent]
+	OnUserAuthRefreshRequest          *hook.Hook[*UserAuthRefreshRequestEvent]
+	OnUserRequestPasswordResetRequest *hook.Hook[*UserRequestPasswordResetRequestEvent]
+	OnUserConfirmPasswordResetRequest *hook.Hook[*UserConfirmPasswordResetRequestEvent]
+	OnUserRequestVerificationRequest  *hook.Hook[*UserRequestVerificationRequestEvent]
+	OnUserConfirmVerificationRequest  *hook.Hook[*UserConfirmVerificationRequestEvent]
+	OnUserRequestEmailChangeRequest   *hook.Hook[*UserRequestEmailChangeRequestEvent]
+	OnUserConfirmEmailChangeRequest   *hook.Hook[*UserConfirmEmailChangeRequestEvent]
+	OnUserRequestOTPRequest           *hook.Hook[*UserRequestOTPRequestEvent]
+	OnUserAuthWithOTPRequest          *hook.Hook[*UserAuthWithOTPRequestEvent]
+}
+
+// Create a new set of proxy hooks and register them
+// on the given app. Keep in mind that calling this
+// multiple times will result in multiple duplicate
+// hooks being registered. So in general that should be
+// avoided.
+//
+// Usage with an exemplary User proxy that has a name field:
+//
+//	pHooks := NewProxyHooks(app)
+//	pHooks.OnUserCreate.BindFunc(func(e *UserEvent) error {
+//		var user *User = e.PRecord // <-- Proxy events contain the proxy in the PRecord field
+//		fmt.Printf("Hello new user, %v!", user.Name())
+//		return e.Next()
+//	})
+func NewProxyHooks(app core.App) *ProxyHooks {
+	pHooks := &ProxyHooks{
+		OnUserEnrich:                      &hook.Hook[*UserEnrichEvent]{},
+		OnUserValidate:                    &hook.Hook[*UserEvent]{},
+		OnUserCreate:                      &hook.Hook[*UserEvent]{},
+		OnUserCreateExecute:               &hook.Hook[*UserEvent]{},
+		OnUserAfterCreateSuccess:          &hook.Hook[*UserEvent]{},
+		OnUserAfterCreateError:            &hook.Hook[*UserErrorEvent]{},
+		OnUserUpdate:                      &UserUpdateHook{},
+		OnUserUpdateExecute:               &UserUpdateHook{},
+		OnUserAfterUpdateSuccess:          &UserUpdateHook{},
+		OnUserAfterUpdateError:            &hook.Hook[*UserErrorEvent]{},
+		OnUserDelete:                      &hook.Hook[*UserEvent]{},
+		OnUserDeleteExecute:               &hook.Hook[*UserEvent]{},
+		OnUserAfterDeleteSuccess:          &hook.Hook[*UserEvent]{},
+		OnUserAfterDeleteError:            &hook.Hook[*UserErrorEvent]{},
+		OnUserListRequest:                 &hook.Hook[*UserListRequestEvent]{},
+		OnUserViewRequest:                 &hook.Hook[*UserRequestEvent]{},
+		OnUserCreateRequest:               &hook.Hook[*UserRequestEvent]{},
+		OnUserUpdateRequest:               &hook.Hook[*UserRequestEvent]{},
+		OnUserDeleteRequest:               &hook.Hook[*UserRequestEvent]{},
+		OnUserAuthRequest:                 &hook.Hook[*UserAuthRequestEvent]{},
+		OnUserAuthWithPasswordRequest:     &hook.Hook[*UserAuthWithPasswordRequestEvent]{},
+		OnUserAuthWithOAuth2Request:       &hook.Hook[*UserAuthWithOAuth2RequestEvent]{},
+		OnUserAuthRefreshRequest:          &hook.Hook[*UserAuthRefreshRequestEvent]{},
+		OnUserRequestPasswordResetRequest: &hook.Hook[*UserRequestPasswordResetRequestEvent]{},
+		OnUserConfirmPasswordResetRequest: &hook.Hook[*UserConfirmPasswordResetRequestEvent]{},
+		OnUserRequestVerificationRequest:  &hook.Hook[*UserRequestVerificationRequestEvent]{},
+		OnUserConfirmVerificationRequest:  &hook.Hook[*UserConfirmVerificationRequestEvent]{},
+		OnUserRequestEmailChangeRequest:   &hook.Hook[*UserRequestEmailChangeRequestEvent]{},
+		OnUserConfirmEmailChangeRequest:   &hook.Hook[*UserConfirmEmailChangeRequestEvent]{},
+		OnUserRequestOTPRequest:           &hook.Hook[*UserRequestOTPRequestEvent]{},
+		OnUserAuthWithOTPRequest:          &hook.Hook[*UserAuthWithOTPRequestEvent]{},
+	}
+	pHooks.registerProxyHooks(app)
+	return pHooks
+}
+
+func (pHooks *ProxyHooks) registerProxyHooks(app core.App) {
+	registerProxyEnrichEventHook(app.OnRecordEnrich("users"), pHooks.OnUserEnrich)
+	registerProxyEventHook(app.OnRecordValidate("users"), pHooks.OnUserValidate)
+	registerProxyEventHook(app.OnRecordCreate("users"), pHooks.OnUserCreate)
+	registerProxyEventHook(app.OnRecordCreateExecute("users"), pHooks.OnUserCreateExecute)
+	registerProxyEventHook(app.OnRecordAfterCreateSuccess("users"), pHooks.OnUserAfterCreateSuccess)
+	registerProxyErrorEventHook(app.OnRecordAfterCreateError("users"), pHooks.OnUserAfterCreateError)
+	registerProxyEventHook(app.OnRecordUpdate("users"), &pHooks.OnUserUpdate.Hook)
+	registerProxyEventHook(app.OnRecordUpdateExecute("users"), &pHooks.OnUserUpdateExecute.Hook)
+	registerProxyEventHook(app.OnRecordAfterUpdateSuccess("users"), &pHooks.OnUserAfterUpdateSuccess.Hook)
+	registerProxyErrorEventHook(app.OnRecordAfterUpdateError("users"), pHooks.OnUserAfterUpdateError)
+	registerProxyEventHook(app.OnRecordDelete("users"), pHooks.OnUserDelete)
+	registerProxyEventHook(app.OnRecordDeleteExecute("users"), pHooks.OnUserDeleteExecute)
+	registerProxyEventHook(app.OnRecordAfterDeleteSuccess("users"), pHooks.OnUserAfterDeleteSuccess)
+	registerProxyErrorEventHook(app.OnRecordAfterDeleteError("users"), pHooks.OnUserAfterDeleteError)
+	registerProxyListRequestEventHook(app.OnRecordsListRequest("users"), pHooks.OnUserListRequest)
+	registerProxyRequestEventHook(app.OnRecordViewRequest("users"), pHooks.OnUserViewRequest)
+	registerProxyRequestEventHook(app.OnRecordCreateRequest("users"), pHooks.OnUserCreateRequest)
+	registerProxyRequestEventHook(app.OnRecordUpdateRequest("users"), pHooks.OnUserUpdateRequest)
+	registerProxyRequestEventHook(app.OnRecordDeleteRequest("users"), pHooks.OnUserDeleteRequest)
+	registerProxyAuthEventHook(app.OnRecordAuthRequest("users"), pHooks.OnUserAuthRequest)
+	registerProxyAuthEventHook(app.OnRecordAuthWithPasswordRequest("users"), pHooks.OnUserAuthWithPasswordRequest)
+	registerProxyAuthEventHook(app.OnRecordAuthWithOAuth2Request("users"), pHooks.OnUserAuthWithOAuth2Request)
+	registerProxyAuthEventHook(app.OnRecordAuthRefreshRequest("users"), pHooks.OnUserAuthRefreshRequest)
+	registerProxyAuthEventHook(app.OnRecordRequestPasswordResetRequest("users"), pHooks.OnUserRequestPasswordResetRequest)
+	registerProxyAuthEventHook(app.OnRecordConfirmPasswordResetRequest("users"), pHooks.OnUserConfirmPasswordResetRequest)
+	registerProxyAuthEventHook(app.OnRecordRequestVerificationRequest("users"), pHooks.OnUserRequestVerificationRequest)
+	registerProxyAuthEventHook(app.OnRecordConfirmVerificationRequest("users"), pHooks.OnUserConfirmVerificationRequest)
+	registerProxyAuthEventHook(app.OnRecordRequestEmailChangeRequest("users"), pHooks.OnUserRequestEmailChangeRequest)
+	registerProxyAuthEventHook(app.OnRecordConfirmEmailChangeRequest("users"), pHooks.OnUserConfirmEmailChangeRequest)
+	registerProxyAuthEventHook(app.OnRecordRequestOTPRequest("users"), pHooks.OnUserRequestOTPRequest)
+	registerProxyAuthEventHook(app.OnRecordAuthWithOTPRequest("users"), pHooks.OnUserAuthWithOTPRequest)
+}
+`
+
+	ok, err := expectGeneratedHooks(template, expectedGeneration)
+	if err != nil {
+		t.Fatal("error during generation of hooks")
+	}
+	if !ok {
+		t.Fatal("the auth template did not have the expected hooks generation result")
+	}
+}
+
 func expectGeneratedHooks(input, expectedOutput string) (bool, error) {
 	input = addBoilerplate(input)
 
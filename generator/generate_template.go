@@ -94,8 +94,7 @@ func (t *SchemaTranslator) translateFields(collection *core.Collection) (*ast.Fi
 			return nil, err
 		}
 		if i == 0 {
-			c := createCollectionNameComment(collection.Name)
-			translated.Doc.List = slices.Insert(translated.Doc.List, 0, c)
+			translated.Doc.List = slices.Concat(createCollectionComments(collection), translated.Doc.List)
 		}
 		fields[i] = translated
 	}
@@ -172,6 +171,8 @@ func (t *SchemaTranslator) goType(field core.Field) ast.Expr {
 		typeName = "string"
 	case *core.PasswordField:
 		typeName = "string"
+	case *core.GeoPointField:
+		typeName = "types.GeoPoint"
 	}
 
 	multi, ok := field.(core.MultiValuer)
@@ -195,6 +196,13 @@ func createFieldDoc(col *core.Collection, field core.Field) (*ast.CommentGroup,
 	if systemComment := createSystemFieldComment(field); systemComment != nil {
 		comments = append(comments, systemComment)
 	}
+	if fileComment := createFileComment(field); fileComment != nil {
+		comments = append(comments, fileComment)
+	}
+	comments = append(comments, createConstraintComments(col, field)...)
+	if idComment := createFieldIdComment(field); idComment != nil {
+		comments = append(comments, idComment)
+	}
 	doc := &ast.CommentGroup{List: comments}
 	return doc, nil
 }
@@ -205,40 +213,53 @@ func createSelectTypeComment(col *core.Collection, field core.Field) (*ast.Comme
 		return nil, nil
 	}
 	selectTypeName := strcase.ToCamel(col.Name) + strcase.ToCamel(selectField.Name) + "Options"
-	selectOptions := selectField.Values
+	selectOptions, selectVarNames, err := selectOptionIdentifiers(col, selectField)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &ast.Comment{Text: selectTypeCommentText(selectTypeName, selectOptions, selectVarNames)}
+	return comment, nil
+}
+
+// Returns the select options as they are written in the
+// '// select:' comment together with the default const names
+func selectOptionIdentifiers(col *core.Collection, selectField *core.SelectField) ([]string, []string, error) {
+	selectOptions := make([]string, len(selectField.Values))
+	selectVarNames := make([]string, len(selectField.Values))
+	for i, o := range selectField.Values {
+		option, err := validateIdentifier(o)
+		if err != nil {
+			return nil, nil, err
+		}
+		varName, err := validateIdentifier(strcase.ToCamel(col.Name) + strcase.ToCamel(selectField.Name) + o)
+		if err != nil {
+			return nil, nil, err
+		}
+		selectOptions[i] = option
+		selectVarNames[i] = varName
+	}
+	return selectOptions, selectVarNames, nil
+}
 
+// Writes a '// select:' comment. The [] are
+// omitted when no const names are given.
+func selectTypeCommentText(selectTypeName string, selectOptions, selectVarNames []string) string {
 	var sb strings.Builder
 
 	sb.WriteString(selectTypeComment)
 	sb.WriteString(" ")
 	sb.WriteString(selectTypeName)
 	sb.WriteString("(")
-	for i, o := range selectOptions {
-		o, err := validateIdentifier(o)
-		if err != nil {
-			return nil, err
-		}
-		sb.WriteString(o)
-		if i < len(selectOptions)-1 {
-			sb.WriteString(", ")
-		}
-	}
+	sb.WriteString(strings.Join(selectOptions, ", "))
 	sb.WriteString(")")
-	sb.WriteString("[")
-	for i, o := range selectOptions {
-		o, err := validateIdentifier(strcase.ToCamel(col.Name) + strcase.ToCamel(selectField.Name) + o)
-		if err != nil {
-			return nil, err
-		}
-		sb.WriteString(o)
-		if i < len(selectOptions)-1 {
-			sb.WriteString(", ")
-		}
+	if selectVarNames != nil {
+		sb.WriteString("[")
+		sb.WriteString(strings.Join(selectVarNames, ", "))
+		sb.WriteString("]")
 	}
-	sb.WriteString("]")
 
-	comment := &ast.Comment{Text: sb.String()}
-	return comment, nil
+	return sb.String()
 }
 
 func createSystemFieldComment(field core.Field) *ast.Comment {
@@ -249,6 +270,138 @@ func createSystemFieldComment(field core.Field) *ast.Comment {
 	return comment
 }
 
+func createFileComment(field core.Field) *ast.Comment {
+	if _, ok := field.(*core.FileField); !ok {
+		return nil
+	}
+	return &ast.Comment{Text: fileComment}
+}
+
+// Records the constraints of a non-system field
+// as '// required:', '// min:', ... comments.
+// View collections are read-only and have none.
+func createConstraintComments(col *core.Collection, field core.Field) []*ast.Comment {
+	if field.GetSystem() || col.IsView() {
+		return nil
+	}
+
+	lines := make([]string, 0, 4)
+	add := func(directive string, value any) {
+		line := directive
+		if value != "" {
+			line += fmt.Sprintf(" %v", value)
+		}
+		lines = append(lines, line)
+	}
+	addRequired := func(required bool) {
+		if required {
+			add(requiredComment, "")
+		}
+	}
+	addMaxSelect := func(multiple bool, maxSelect int) {
+		if multiple && maxSelect > 0 {
+			add(maxSelectComment, maxSelect)
+		}
+	}
+
+	switch f := field.(type) {
+	case *core.TextField:
+		addRequired(f.Required)
+		if f.Min > 0 {
+			add(minComment, f.Min)
+		}
+		if f.Max > 0 {
+			add(maxComment, f.Max)
+		}
+		if f.Pattern != "" {
+			add(patternComment, f.Pattern)
+		}
+	case *core.EditorField:
+		addRequired(f.Required)
+		if f.MaxSize > 0 {
+			add(maxSizeComment, f.MaxSize)
+		}
+	case *core.NumberField:
+		addRequired(f.Required)
+		if f.Min != nil {
+			add(minComment, formatLimit(*f.Min))
+		}
+		if f.Max != nil {
+			add(maxComment, formatLimit(*f.Max))
+		}
+	case *core.EmailField:
+		addRequired(f.Required)
+		if len(f.OnlyDomains) > 0 {
+			add(onlyDomainsComment, "email("+strings.Join(f.OnlyDomains, ", ")+")")
+		}
+	case *core.URLField:
+		addRequired(f.Required)
+		if len(f.OnlyDomains) > 0 {
+			add(onlyDomainsComment, "url("+strings.Join(f.OnlyDomains, ", ")+")")
+		}
+	case *core.SelectField:
+		addRequired(f.Required)
+		addMaxSelect(f.IsMultiple(), f.MaxSelect)
+	case *core.RelationField:
+		addRequired(f.Required)
+		addMaxSelect(f.IsMultiple(), f.MaxSelect)
+	case *core.FileField:
+		addRequired(f.Required)
+		addMaxSelect(f.IsMultiple(), f.MaxSelect)
+		if f.MaxSize > 0 {
+			add(maxSizeComment, f.MaxSize)
+		}
+	case *core.JSONField:
+		if f.Required {
+			add(requiredComment, "json")
+		}
+		if f.MaxSize > 0 {
+			add(maxSizeComment, fmt.Sprintf("json(%v)", f.MaxSize))
+		}
+	case *core.BoolField:
+		addRequired(f.Required)
+	case *core.DateField:
+		addRequired(f.Required)
+	case *core.GeoPointField:
+		addRequired(f.Required)
+	}
+
+	comments := make([]*ast.Comment, len(lines))
+	for i, l := range lines {
+		comments[i] = &ast.Comment{Text: l}
+	}
+	return comments
+}
+
+// Returns the comments that go on the first field of a collection struct
+func createCollectionComments(collection *core.Collection) []*ast.Comment {
+	comments := []*ast.Comment{createCollectionNameComment(collection.Name)}
+	if c := createCollectionIdComment(collection); c != nil {
+		comments = append(comments, c)
+	}
+	if c := createCollectionTypeComment(collection); c != nil {
+		comments = append(comments, c)
+	}
+	if c := createViewQueryComment(collection); c != nil {
+		comments = append(comments, c)
+	}
+	return comments
+}
+
+func createCollectionIdComment(collection *core.Collection) *ast.Comment {
+	if collection.Id == "" {
+		return nil
+	}
+	return &ast.Comment{Text: collectionIdComment + " " + collection.Id}
+}
+
+func createFieldIdComment(field core.Field) *ast.Comment {
+	if field.GetId() == "" {
+		return nil
+	}
+	return &ast.Comment{Text: fieldIdComment + " " + field.GetId()}
+}
+
 func createCollectionNameComment(collectionName string) *ast.Comment {
 	comment := &ast.Comment{
 		Text: collectionNameComment + " " + collectionName,
@@ -256,6 +409,28 @@ func createCollectionNameComment(collectionName string) *ast.Comment {
 	return comment
 }
 
+func createCollectionTypeComment(collection *core.Collection) *ast.Comment {
+	if collection.Type != core.CollectionTypeAuth && collection.Type != core.CollectionTypeView {
+		return nil
+	}
+	comment := &ast.Comment{
+		Text: collectionTypeComment + " " + collection.Type,
+	}
+	return comment
+}
+
+// Records the query of a view collection on a single line
+func createViewQueryComment(collection *core.Collection) *ast.Comment {
+	if !collection.IsView() {
+		return nil
+	}
+	query := strings.Join(strings.Fields(collection.ViewQuery), " ")
+	comment := &ast.Comment{
+		Text: viewQueryComment + " " + query,
+	}
+	return comment
+}
+
 func wrapTemplateDeclarations(decls []ast.Decl, packageName string) *ast.File {
 	f := &ast.File{
 		Doc:   newInfoComment(),
@@ -286,8 +461,23 @@ func newInfoComment() *ast.CommentGroup {
 			{Text: "//  - Change the const names of the select options by adding a pair of [] to the // select: comment."},
 			{Text: "//    Example: // select: MySelectType(optionA, optionB)[OpA, OpB] <-- These constants will represent"},
 			{Text: "//    the select options (like an enum). If you omit the [] the option names are used directly."},
+			{Text: "//  - Add a '// select-unknown: error|raw|panic' comment to a select field to choose how its getters"},
+			{Text: "//    handle options that the template does not know. With 'error' (the default) the getter returns the"},
+			{Text: "//    Unset constant and the E getter an error. 'raw' returns no error but keeps the option readable"},
+			{Text: "//    with a Raw getter. 'panic' panics like older versions of the generator did. Setters always panic"},
+			{Text: "//    on values that are not an option so that no write gets lost."},
+			{Text: "//  - Change the type of a select field from int to string (or []int to []string) to get a string based"},
+			{Text: "//    select type. Its constants hold the option values, so reordering the options in PocketBase does"},
+			{Text: "//    not change their meaning."},
 			{Text: "//  - Edit the field names. If you do, the generator still needs to know the original database field name."},
 			{Text: "//    To provide this, add a '// schema-name: [original field name]' comment directly above the field."},
+			{Text: "//  - Add a '// json: pkg.MyType' comment to a json field to get getters/setters that decode/encode"},
+			{Text: "//    the json value as MyType. A bare '// json:' comment uses the type of the field itself."},
+			{Text: "//  - Keep the '// file:' comments on file fields to get upload setters, append/remove helpers and"},
+			{Text: "//    URL builders. Removing the comment leaves a plain string or []string field."},
+			{Text: "//  - Edit or remove the constraint comments ('// required:', '// min:', '// max:', '// pattern:',"},
+			{Text: "//    '// max-select:', '// max-size:', '// only-domains:'). Proxies of structs with constraints get a"},
+			{Text: "//    Validate() method that checks them with the same error messages that PocketBase uses."},
 			{Text: "//  - Add methods to the template structs. The generator will replace any fields you access with the also"},
 			{Text: "//    generated getters/setters. Be aware of that when repeatedly assigning a template field. You are"},
 			{Text: "//    calling a setter on every assignment. The methods can also call each other."},
@@ -297,14 +487,16 @@ func newInfoComment() *ast.CommentGroup {
 			{Text: "//  - Add fields that are not part of the PB schema to the structs."},
 			{Text: "//  - Change the '// collection-name:' comments unless the collection was actually renamed."},
 			{Text: "//    If the comment is missing from the first struct field, the generator will print a warning."},
+			{Text: "//  - Change the '// collection-type:' comments. Auth collection proxies get their auth methods from it"},
+			{Text: "//    and view collection proxies are generated without setters."},
 			{Text: "//  - Change the select values in the () of the '// select:' comments'"},
 			{Text: "//  - Remove the '// system:' doc comments from the system fields. Generation will fail if you do so."},
 			{Text: "//  - Shadow any names from the core.Record struct. Generation will also fail for safety."},
 			{Text: "//  - Rename fields without preserving the original name with a '// schema-name:' comment.'"},
 			{Text: "//"},
-			{Text: "// If you edit this file, be careful and back it up to prevent the changes from being overridden by"},
-			{Text: "// subsequent runs of the template command. Check out the PocketBase docs to find out how to use the"},
-			{Text: "// generated code in your code: https://pocketbase.io/docs/go-record-proxy/"},
+			{Text: "// If you edit this file, run the template command with the --update flag to merge later schema changes"},
+			{Text: "// into it. Without the flag the file is overridden. Check out the PocketBase docs to find out how to use"},
+			{Text: "// the generated code in your code: https://pocketbase.io/docs/go-record-proxy/"},
 		},
 	}
 }
@@ -11,61 +11,107 @@ import (
 
 var expectedAuthCollectionStruct = `type AuthCollection struct {
 	// collection-name: auth_collection
+	// collection-id: _pb_users_auth_
+	// collection-type: auth
 	// system: id
+	// field-id: text3208210256
 	Id string
 	// system: password
+	// field-id: password901924565
 	password string
 	// system: tokenKey
+	// field-id: text2504183744
 	tokenKey string
 	// system: email
+	// field-id: email3885137012
 	email string
 	// system: emailVisibility
+	// field-id: bool1547992806
 	emailVisibility bool
 	// system: verified
+	// field-id: bool256245529
 	verified bool
-	name     string
-	avatar   string
-	created  types.DateTime
-	updated  types.DateTime
+	// max: 255
+	// field-id: text1579384326
+	name string
+	// file:
+	// field-id: file376926767
+	avatar string
+	// field-id: autodate2990389176
+	created types.DateTime
+	// field-id: autodate3332085495
+	updated types.DateTime
 }
 `
 
 var expectedAllTypesCollectionStruct = `type AllFieldTypes struct {
 	// collection-name: all_field_types
+	// collection-id: pbc_856668217
 	// system: id
-	Id             string
-	text           string
-	richText       string
-	oneFile        string
-	manyFiles      []string
+	// field-id: text3208210256
+	Id string
+	// field-id: text1579384326
+	text string
+	// field-id: editor145151535
+	richText string
+	// file:
+	// field-id: file2388079447
+	oneFile string
+	// file:
+	// max-select: 99
+	// field-id: file2702582153
+	manyFiles []string
+	// field-id: number1587350850
 	floatingNumber float64
+	// field-id: number139866725
 	intergerNumber int
-	boolValue      bool
-	email          string
-	url            string
-	date           types.DateTime
-	// select: SingleSelectSelectType(optionA, optionB, optionC)
+	// field-id: bool2232122523
+	boolValue bool
+	// field-id: email3885137012
+	email string
+	// field-id: url4101391790
+	url string
+	// field-id: date2862495610
+	date types.DateTime
+	// select: AllFieldTypesSingleSelectOptions(optionA, optionB, optionC)[AllFieldTypesSingleSelectoptionA, AllFieldTypesSingleSelectoptionB, AllFieldTypesSingleSelectoptionC]
+	// field-id: select1474461083
 	singleSelect int
-	// select: MultiSelectSelectType(optionD, optionE)
-	multiSelect    []int
-	json           string
+	// select: AllFieldTypesMultiSelectOptions(optionD, optionE)[AllFieldTypesMultiSelectoptionD, AllFieldTypesMultiSelectoptionE]
+	// max-select: 2
+	// field-id: select2438500022
+	multiSelect []int
+	// field-id: json1795630405
+	json string
+	// field-id: relation1437456673
 	singleRelation *AllFieldTypes
-	multiRelation  []*AllFieldTypes
-	created        types.DateTime
-	updated        types.DateTime
+	// max-select: 999
+	// field-id: relation1362355922
+	multiRelation []*AllFieldTypes
+	// field-id: autodate2990389176
+	created types.DateTime
+	// field-id: autodate3332085495
+	updated types.DateTime
 }
 `
 
 var expectedReservedGoNameCollectionStruct = `type WithReservedGoNames struct {
 	// collection-name: with_reserved_go_names
+	// collection-id: pbc_765345972
 	// system: id
-	Id      string
-	func_   string
-	type_   string
+	// field-id: text3208210256
+	Id string
+	// field-id: text1123462067
+	func_ string
+	// field-id: text2363381545
+	type_ string
+	// field-id: text3130185518
 	struct_ string
-	// select: VarSelectType(var_, struct_)
-	var_    int
+	// select: WithReservedGoNamesVarOptions(var_, struct_)[WithReservedGoNamesVarvar, WithReservedGoNamesVarstruct]
+	// field-id: select1842382598
+	var_ int
+	// field-id: autodate2990389176
 	created types.DateTime
+	// field-id: autodate3332085495
 	updated types.DateTime
 }
 `
@@ -100,6 +146,41 @@ func TestTemplate(t *testing.T) {
 	}
 }
 
+var expectedGeoPointCollectionStruct = `type Places struct {
+	// collection-name: places
+	// collection-id: pbc_1794213710
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// field-id: text1579384326
+	name string
+	// field-id: geoPoint1587448267
+	location types.GeoPoint
+}
+`
+
+func TestTemplateGeoPoint(t *testing.T) {
+	collections, err := ParseSchemaJson("./db_test/geo_schema.json", false)
+	if err != nil {
+		t.Fatalf("Error during schema parsing: %v", err)
+	}
+
+	template, err := Template(collections, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during template generation: %v", err)
+	}
+
+	structDefs := separateTemplateStructs(template)
+
+	if len(structDefs) != 1 {
+		t.Fatal("the number of struct definitions does not match the number of collections in the schema")
+	}
+
+	if structDefs[0] != expectedGeoPointCollectionStruct {
+		t.Fatal("the test collection containing a geo point field did not result in the expected template struct")
+	}
+}
+
 func TestTemplatePackageName(t *testing.T) {
 	_, err := Template(nil, ".", "validpackagename")
 	if err != nil {
@@ -143,3 +224,40 @@ func separateTemplateStructs(templateFile []byte) []string {
 
 	return structs
 }
+
+var expectedViewCollectionStruct = `type PostStats struct {
+	// collection-name: post_stats
+	// collection-id: pbc_2204154511
+	// collection-type: view
+	// view-query: SELECT posts.id, posts.title, COUNT(comments.id) AS comments FROM posts LEFT JOIN comments ON comments.post = posts.id GROUP BY posts.id
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// field-id: _clone_Bs9y
+	title string
+	// field-id: number2245608546
+	comments float64
+}
+`
+
+func TestTemplateView(t *testing.T) {
+	collections, err := ParseSchemaJson("./db_test/view_schema.json", false)
+	if err != nil {
+		t.Fatalf("Error during schema parsing: %v", err)
+	}
+
+	template, err := Template(collections, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during template generation: %v", err)
+	}
+
+	structDefs := separateTemplateStructs(template)
+
+	if len(structDefs) != 1 {
+		t.Fatal("the number of struct definitions does not match the number of collections in the schema")
+	}
+
+	if structDefs[0] != expectedViewCollectionStruct {
+		t.Fatal("the test view collection did not result in the expected template struct")
+	}
+}
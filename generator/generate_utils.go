@@ -3,6 +3,7 @@ package generator
 import (
 	"go/ast"
 
+	"github.com/pocketbase/pocketbase/core"
 	"github.com/snonky/astpos/astpos"
 )
 
@@ -28,13 +29,46 @@ func utilsFromTemplate(parser *Parser) []ast.Decl {
 
 	decls := []ast.Decl{
 		newProxyTypeConstraint(structNames),
-		proxyPInterfaceTemplate,
+		newProxyPInterface(parser.lookupById),
 		collectionNameUtilTemplate,
+	}
+
+	lookupDecls := []ast.Decl{
 		newProxyUtilTemplate,
 		wrapRecordUtilTemplate,
 		wrapRecordsUtilTemplate,
+	}
+	lookupDecls = append(lookupDecls, finderUtilTemplates...)
+	lookupDecls = append(lookupDecls, authUtilTemplates...)
+	for _, decl := range lookupDecls {
+		if parser.lookupById {
+			decl = newIdLookupDecl(decl)
+		}
+		decls = append(decls, decl)
+	}
+	decls = append(decls,
 		relationFieldStructTemplate,
 		createRelationMapDecl(structNames, parser),
+	)
+	decls = append(decls, filterUtilTemplates...)
+	decls = append(decls, expandUtilTemplates...)
+	decls = append(decls, newExpandRootDecl(structNames))
+	decls = append(decls, fieldUtilTemplates...)
+
+	for _, structName := range structNames {
+		fields := parser.structFields[structName]
+		decls = append(decls, newFilterBuilderDecl(structName, fields))
+		decls = append(decls, newExpandPathDecls(
+			structName,
+			relationFields(fields, parser),
+			parser.backRelationFields(structName),
+		)...)
+		if parser.collectionNames[structName] != "" {
+			decls = append(decls, newCollectionFinderDecls(structName)...)
+		}
+		if parser.collectionTypes[structName] == core.CollectionTypeAuth {
+			decls = append(decls, newAuthGetterDecl(structName))
+		}
 	}
 
 	return decls
@@ -102,6 +136,17 @@ func relationsOfCollection(structName string, parser *Parser) map[string][]relat
 	return relations
 }
 
+// Returns the fields that relate to another proxy
+func relationFields(fields []*Field, parser *Parser) []*Field {
+	relFields := make([]*Field, 0, len(fields))
+	for _, f := range fields {
+		if relatedTypeName, _ := relatedTypeAndMulti(f, parser); relatedTypeName != "" {
+			relFields = append(relFields, f)
+		}
+	}
+	return relFields
+}
+
 func relatedTypeAndMulti(field *Field, parser *Parser) (string, bool) {
 	fieldTypeName := baseType(field.fieldType).Name
 
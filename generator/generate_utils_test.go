@@ -1,9 +1,16 @@
 package generator_test
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
 	"testing"
 
 	. "github.com/nedieyassin/pocketbase-gogen/generator"
+	"golang.org/x/tools/go/packages"
 )
 
 func TestUtilsGeneration(t *testing.T) {
@@ -11,6 +18,8 @@ func TestUtilsGeneration(t *testing.T) {
 	// collection-name: collection_1
 	// system: id
 	id string
+	// select: StatusType(active, inactive)
+	status int
 }
 
 type Proxy2 struct {
@@ -29,6 +38,7 @@ type Proxy3 struct {
 
 type Proxy4 struct {
 	// collection-name: collection_4
+	// collection-type: auth
 	// system: id
 	id      string
 	other2  *Proxy2
@@ -91,7 +101,7 @@ type ProxyP[P Proxy] interface {
 // Returns the collection name of a proxy type
 //
 //	collectionName := CName[ProxyType]()
-func CName[P Proxy, PP ProxyP[P]]() string {
+func CollectionNameOf[P Proxy, PP ProxyP[P]]() string {
 	return PP.CollectionName(nil)
 }
 
@@ -124,6 +134,107 @@ func WrapRecord[P Proxy, PP ProxyP[P]](record *core.Record) (PP, error) {
 	return p, nil
 }
 
+func WrapRecords[P Proxy, PP ProxyP[P]](records []*core.Record) ([]PP, error) {
+	var ms []PP
+	for _, record := range records {
+		collectionName := record.Collection().Name
+		proxyCollectionName := PP.CollectionName(nil)
+		if collectionName != proxyCollectionName {
+			return nil, errors.New("the generic proxy type is not of the same collection as the given record")
+		}
+		var p PP = &P{}
+		p.SetProxyRecord(record)
+		ms = append(ms, p)
+	}
+	return ms, nil
+}
+
+// Finds the record with the given id and wraps it in a proxy
+//
+//	proxy, err := FindById[ProxyType](app, id)
+func FindById[P Proxy, PP ProxyP[P]](app core.App, id string) (PP, error) {
+	record, err := app.FindRecordById(PP.CollectionName(nil), id)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecord[P, PP](record)
+}
+
+// Finds the records with the given ids and wraps them in proxies
+//
+//	proxies, err := FindByIds[ProxyType](app, ids)
+func FindByIds[P Proxy, PP ProxyP[P]](app core.App, ids []string) ([]PP, error) {
+	records, err := app.FindRecordsByIds(PP.CollectionName(nil), ids)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecords[P, PP](records)
+}
+
+// Finds the first record that matches the filter and wraps it in a proxy
+//
+//	proxy, err := FindFirstByFilter[ProxyType](app, "name = {:name}", dbx.Params{"name": name})
+func FindFirstByFilter[P Proxy, PP ProxyP[P]](app core.App, filter string, params ...dbx.Params) (PP, error) {
+	record, err := app.FindFirstRecordByFilter(PP.CollectionName(nil), filter, params...)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecord[P, PP](record)
+}
+
+// Finds all records that match the filter and wraps them in proxies.
+// A limit of 0 returns all records.
+//
+//	proxies, err := FindAllByFilter[ProxyType](app, "age > {:age}", "-created", 10, 0, dbx.Params{"age": 18})
+func FindAllByFilter[P Proxy, PP ProxyP[P]](app core.App, filter string, sort string, limit int, offset int, params ...dbx.Params) ([]PP, error) {
+	records, err := app.FindRecordsByFilter(PP.CollectionName(nil), filter, sort, limit, offset, params...)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecords[P, PP](records)
+}
+
+// Counts the records of a proxy type that match the expressions
+//
+//	count, err := Count[ProxyType](app, dbx.HashExp{"verified": true})
+func Count[P Proxy, PP ProxyP[P]](app core.App, exprs ...dbx.Expression) (int64, error) {
+	return app.CountRecords(PP.CollectionName(nil), exprs...)
+}
+
+// Returns the auth record of the request wrapped in a proxy.
+// ok is false if the request is not authenticated or the
+// auth record belongs to another collection.
+//
+//	user, ok := AuthAs[*User](e)
+func AuthAs[PP ProxyP[P], P Proxy](e *core.RequestEvent) (PP, bool) {
+	if e.Auth == nil || e.Auth.Collection().Name != PP.CollectionName(nil) {
+		return nil, false
+	}
+	var p PP = &P{}
+	p.SetProxyRecord(e.Auth)
+	return p, true
+}
+
+// Creates a middleware that requires the request to be
+// authenticated with a record of the proxy's collection
+//
+//	se.Router.GET("/hello", handler).Bind(RequireAuthAs[*User]())
+func RequireAuthAs[PP ProxyP[P], P Proxy]() *hook.Handler[*core.RequestEvent] {
+	return &hook.Handler[*core.RequestEvent]{Func: requireAuthAs(PP.CollectionName(nil))}
+}
+
+func requireAuthAs(collectionName string) func(*core.RequestEvent) error {
+	return func(e *core.RequestEvent) error {
+		if e.Auth == nil {
+			return e.UnauthorizedError("The request requires valid record authorization token.", nil)
+		}
+		if e.Auth.Collection().Name != collectionName {
+			return e.ForbiddenError("The authorized record is not allowed to perform this action.", nil)
+		}
+		return e.Next()
+	}
+}
+
 type RelationField struct {
 	FieldName string
 	IsMulti   bool
@@ -160,6 +271,481 @@ var Relations = map[string]map[string][]RelationField{
 		},
 	},
 }
+
+// A PocketBase filter expression. Filters are created with the
+// typed filter builders of the proxies and can be combined:
+//
+//	filter := PersonFilter.Name.Eq("Bob").And(PersonFilter.Age.Gte(18))
+//	expr, params := filter.Build()
+//	records, err := app.FindRecordsByFilter("person", expr, "", 0, 0, params)
+type Filter struct {
+	// The comparison operator of a field filter or
+	// the logical operator of a filter group
+	op string
+	// Only set for field filters
+	field string
+	value any
+	// Only set for filter groups
+	filters []Filter
+}
+
+// Combines the filter with others so that all of them have to match
+func (f Filter) And(filters ...Filter) Filter {
+	return newFilterGroup("&&", f, filters)
+}
+
+// Combines the filter with others so that at least one of them has to match
+func (f Filter) Or(filters ...Filter) Filter {
+	return newFilterGroup("||", f, filters)
+}
+
+func newFilterGroup(op string, first Filter, others []Filter) Filter {
+	filters := make([]Filter, 0, len(others)+1)
+	filters = append(filters, first)
+	filters = append(filters, others...)
+	return Filter{
+		op:      op,
+		filters: filters,
+	}
+}
+
+// Renders the filter to the PocketBase filter syntax.
+// The returned params hold the values of the placeholders
+// in the expression. An empty && group matches every record
+// and an empty || group matches none.
+func (f Filter) Build() (string, dbx.Params) {
+	params := dbx.Params{}
+	expr := f.build(params)
+	return expr, params
+}
+
+func (f Filter) build(params dbx.Params) string {
+	if f.field != "" {
+		name := fmt.Sprintf("p%d", len(params))
+		params[name] = f.value
+		return f.field + " " + f.op + " {:" + name + "}"
+	}
+	switch len(f.filters) {
+	case 0:
+		if f.op == "&&" {
+			return "1 = 1"
+		}
+		return "1 = 0"
+	case 1:
+		return f.filters[0].build(params)
+	}
+	exprs := make([]string, len(f.filters))
+	for i, filter := range f.filters {
+		exprs[i] = filter.build(params)
+	}
+	return "(" + strings.Join(exprs, " "+f.op+" ") + ")"
+}
+
+// A typed field of a proxy filter builder. The type parameter is
+// the Go type of the field (the element type for multi fields).
+// Select type values are translated to their option strings.
+// The operators of multi fields match if any of their values
+// matches. Neq, NotLike and NotIn match if none of them does.
+type FilterField[T any] struct {
+	name    string
+	toValue func(
+		T) any
+	isMulti bool
+}
+
+func newFilterField[T any](name string) FilterField[T] {
+	return FilterField[T]{name: name}
+}
+
+func newSelectFilterField[T comparable](name string, options map[T]string) FilterField[T] {
+	toValue := func(value T) any {
+		return options[value]
+	}
+	return FilterField[T]{
+		name:    name,
+		toValue: toValue,
+	}
+}
+
+// Marks the field as a multi field
+func (f FilterField[T]) multi() FilterField[T] {
+	f.isMulti = true
+	return f
+}
+
+func (f FilterField[T]) Eq(value T) Filter {
+	return f.compare("=", value)
+}
+
+func (f FilterField[T]) Neq(value T) Filter {
+	return f.compare("!=", value)
+}
+
+func (f FilterField[T]) Gt(value T) Filter {
+	return f.compare(">", value)
+}
+
+func (f FilterField[T]) Gte(value T) Filter {
+	return f.compare(">=", value)
+}
+
+func (f FilterField[T]) Lt(value T) Filter {
+	return f.compare("<", value)
+}
+
+func (f FilterField[T]) Lte(value T) Filter {
+	return f.compare("<=", value)
+}
+
+func (f FilterField[T]) Like(value T) Filter {
+	return f.compare("~", value)
+}
+
+func (f FilterField[T]) NotLike(value T) Filter {
+	return f.compare("!~", value)
+}
+
+// Matches multi fields that contain the value
+func (f FilterField[T]) Has(value T) Filter {
+	return f.compare("?=", value)
+}
+
+// Matches if the field equals any of the values
+func (f FilterField[T]) In(values ...T) Filter {
+	filters := make([]Filter, len(values))
+	for i, value := range values {
+		filters[i] = f.compare("=", value)
+	}
+	return Filter{
+		op:      "||",
+		filters: filters,
+	}
+}
+
+// Matches if the field equals none of the values
+func (f FilterField[T]) NotIn(values ...T) Filter {
+	filters := make([]Filter, len(values))
+	for i, value := range values {
+		filters[i] = f.compare("!=", value)
+	}
+	return Filter{
+		op:      "&&",
+		filters: filters,
+	}
+}
+
+func (f FilterField[T]) compare(op string, value T) Filter {
+	var v any = value
+	if f.toValue != nil {
+		v = f.toValue(value)
+	}
+	field := f.name
+	if f.isMulti {
+		field += ":each"
+		if op != "!=" && op != "!~" && !strings.HasPrefix(op, "?") {
+			op = "?" + op
+		}
+	}
+	return Filter{
+		op:    op,
+		field: field,
+		value: v,
+	}
+}
+
+// Implemented by the typed expand paths that are
+// built from the Expand variable:
+//
+//	path := Expand.Person.Account().Owner() // "account.owner"
+type ExpandPath interface{ expandPath() string }
+
+// Expands the relations of the paths in the proxy record.
+// Returns the errors of the paths that failed to expand.
+//
+//	errs := ExpandProxy(app, person, Expand.Person.Account().Owner(), Expand.Person.Children())
+func ExpandProxy(app core.App, proxy core.RecordProxy, paths ...ExpandPath) map[string]error {
+	expands := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if p := path.expandPath(); p != "" {
+			expands = append(expands, p)
+		}
+	}
+	return app.ExpandRecord(proxy.ProxyRecord(), expands, nil)
+}
+
+func joinExpandPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// The roots of the typed expand paths
+var Expand = struct {
+	Proxy1                Proxy1Expand
+	Proxy2                Proxy2Expand
+	Proxy3                Proxy3Expand
+	Proxy4                Proxy4Expand
+	NoCollectionNameProxy NoCollectionNameProxyExpand
+}{}
+
+// The name of a record field of the proxy type P whose
+// getter returns T. The field name constants of the
+// proxies have this type:
+//
+//	const PersonFieldHealth ProxyField[Person, Health] = "health"
+type ProxyField[P Proxy, T any] string
+
+// Returns the original and the current value of the field.
+// Both are zero values for fields whose original values
+// are not tracked like the fields of views.
+//
+//	oldHealth, newHealth := PersonFieldHealth.Values(person)
+func (f ProxyField[P, T]) Values(p *P) (original T, value T) {
+	tracked, ok := any(p).(interface {
+		fieldValues(field string) (original any, value any)
+	})
+	if !ok {
+		return original, value
+	}
+	o, v := tracked.fieldValues(string(f))
+	original, _ = o.(T)
+	value, _ = v.(T)
+	return original, value
+}
+
+func (f ProxyField[P, T]) key() string {
+	return string(f)
+}
+
+// Ties the field to its proxy type so that only
+// the fields of a proxy are accepted for its hooks
+func (f ProxyField[P, T]) fieldOf(P) {
+}
+
+// The typed filter builder of the Proxy1 proxy
+var Proxy1Filter = struct {
+	Id     FilterField[string]
+	Status FilterField[StatusType]
+}{
+	Id:     newFilterField[string]("id"),
+	Status: newSelectFilterField("status", zzStatusTypeSelectIotaMap),
+}
+
+type Proxy1Expand string
+
+func (e Proxy1Expand) expandPath() string {
+	return string(e)
+}
+
+func (e Proxy1Expand) Proxy2ViaOther1() Proxy2Expand {
+	return Proxy2Expand(joinExpandPath(string(e), "collection_2_via_other1"))
+}
+
+func FindProxy1ById(app core.App, id string) (*Proxy1, error) {
+	return FindById[Proxy1](app, id)
+}
+
+func FindProxy1ByIds(app core.App, ids []string) ([]*Proxy1, error) {
+	return FindByIds[Proxy1](app, ids)
+}
+
+func FindFirstProxy1ByFilter(app core.App, filter string, params ...dbx.Params) (*Proxy1, error) {
+	return FindFirstByFilter[Proxy1](app, filter, params...)
+}
+
+func FindAllProxy1ByFilter(app core.App, filter string, sort string, limit int, offset int, params ...dbx.Params) ([]*Proxy1, error) {
+	return FindAllByFilter[Proxy1](app, filter, sort, limit, offset, params...)
+}
+
+func CountProxy1(app core.App, exprs ...dbx.Expression) (int64, error) {
+	return Count[Proxy1](app, exprs...)
+}
+
+// The typed filter builder of the Proxy2 proxy
+var Proxy2Filter = struct {
+	Id     FilterField[string]
+	Other1 FilterField[string]
+}{
+	Id:     newFilterField[string]("id"),
+	Other1: newFilterField[string]("other1"),
+}
+
+type Proxy2Expand string
+
+func (e Proxy2Expand) expandPath() string {
+	return string(e)
+}
+
+func (e Proxy2Expand) Other1() Proxy1Expand {
+	return Proxy1Expand(joinExpandPath(string(e), "other1"))
+}
+
+func (e Proxy2Expand) Proxy3ViaOthers2() Proxy3Expand {
+	return Proxy3Expand(joinExpandPath(string(e), "collection_3_via_others2"))
+}
+
+func (e Proxy2Expand) Proxy4ViaOther2() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "collection_4_via_other2"))
+}
+
+func FindProxy2ById(app core.App, id string) (*Proxy2, error) {
+	return FindById[Proxy2](app, id)
+}
+
+func FindProxy2ByIds(app core.App, ids []string) ([]*Proxy2, error) {
+	return FindByIds[Proxy2](app, ids)
+}
+
+func FindFirstProxy2ByFilter(app core.App, filter string, params ...dbx.Params) (*Proxy2, error) {
+	return FindFirstByFilter[Proxy2](app, filter, params...)
+}
+
+func FindAllProxy2ByFilter(app core.App, filter string, sort string, limit int, offset int, params ...dbx.Params) ([]*Proxy2, error) {
+	return FindAllByFilter[Proxy2](app, filter, sort, limit, offset, params...)
+}
+
+func CountProxy2(app core.App, exprs ...dbx.Expression) (int64, error) {
+	return Count[Proxy2](app, exprs...)
+}
+
+// The typed filter builder of the Proxy3 proxy
+var Proxy3Filter = struct {
+	Id      FilterField[string]
+	Others2 FilterField[string]
+}{
+	Id:      newFilterField[string]("id"),
+	Others2: newFilterField[string]("others2").multi(),
+}
+
+type Proxy3Expand string
+
+func (e Proxy3Expand) expandPath() string {
+	return string(e)
+}
+
+func (e Proxy3Expand) Others2() Proxy2Expand {
+	return Proxy2Expand(joinExpandPath(string(e), "others2"))
+}
+
+func (e Proxy3Expand) Proxy4ViaOthers3() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "collection_4_via_others3"))
+}
+
+func FindProxy3ById(app core.App, id string) (*Proxy3, error) {
+	return FindById[Proxy3](app, id)
+}
+
+func FindProxy3ByIds(app core.App, ids []string) ([]*Proxy3, error) {
+	return FindByIds[Proxy3](app, ids)
+}
+
+func FindFirstProxy3ByFilter(app core.App, filter string, params ...dbx.Params) (*Proxy3, error) {
+	return FindFirstByFilter[Proxy3](app, filter, params...)
+}
+
+func FindAllProxy3ByFilter(app core.App, filter string, sort string, limit int, offset int, params ...dbx.Params) ([]*Proxy3, error) {
+	return FindAllByFilter[Proxy3](app, filter, sort, limit, offset, params...)
+}
+
+func CountProxy3(app core.App, exprs ...dbx.Expression) (int64, error) {
+	return Count[Proxy3](app, exprs...)
+}
+
+// The typed filter builder of the Proxy4 proxy
+var Proxy4Filter = struct {
+	Id      FilterField[string]
+	Other2  FilterField[string]
+	Others3 FilterField[string]
+	Selfs   FilterField[string]
+	Self    FilterField[string]
+	NoName  FilterField[string]
+}{
+	Id:      newFilterField[string]("id"),
+	Other2:  newFilterField[string]("other2"),
+	Others3: newFilterField[string]("others3").multi(),
+	Selfs:   newFilterField[string]("selfs").multi(),
+	Self:    newFilterField[string]("self"),
+	NoName:  newFilterField[string]("noName"),
+}
+
+type Proxy4Expand string
+
+func (e Proxy4Expand) expandPath() string {
+	return string(e)
+}
+
+func (e Proxy4Expand) Other2() Proxy2Expand {
+	return Proxy2Expand(joinExpandPath(string(e), "other2"))
+}
+
+func (e Proxy4Expand) Others3() Proxy3Expand {
+	return Proxy3Expand(joinExpandPath(string(e), "others3"))
+}
+
+func (e Proxy4Expand) Selfs() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "selfs"))
+}
+
+func (e Proxy4Expand) Self() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "self"))
+}
+
+func (e Proxy4Expand) NoName() NoCollectionNameProxyExpand {
+	return NoCollectionNameProxyExpand(joinExpandPath(string(e), "noName"))
+}
+
+func (e Proxy4Expand) Proxy4ViaSelfs() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "collection_4_via_selfs"))
+}
+
+func (e Proxy4Expand) Proxy4ViaSelf() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "collection_4_via_self"))
+}
+
+func FindProxy4ById(app core.App, id string) (*Proxy4, error) {
+	return FindById[Proxy4](app, id)
+}
+
+func FindProxy4ByIds(app core.App, ids []string) ([]*Proxy4, error) {
+	return FindByIds[Proxy4](app, ids)
+}
+
+func FindFirstProxy4ByFilter(app core.App, filter string, params ...dbx.Params) (*Proxy4, error) {
+	return FindFirstByFilter[Proxy4](app, filter, params...)
+}
+
+func FindAllProxy4ByFilter(app core.App, filter string, sort string, limit int, offset int, params ...dbx.Params) ([]*Proxy4, error) {
+	return FindAllByFilter[Proxy4](app, filter, sort, limit, offset, params...)
+}
+
+func CountProxy4(app core.App, exprs ...dbx.Expression) (int64, error) {
+	return Count[Proxy4](app, exprs...)
+}
+
+// Returns the auth record of the request if it is a Proxy4
+func AuthProxy4(e *core.RequestEvent) (*Proxy4, bool) {
+	return AuthAs[*Proxy4](e)
+}
+
+// The typed filter builder of the NoCollectionNameProxy proxy
+var NoCollectionNameProxyFilter = struct {
+	Id     FilterField[string]
+	Other4 FilterField[string]
+}{
+	Id:     newFilterField[string]("id"),
+	Other4: newFilterField[string]("other4"),
+}
+
+type NoCollectionNameProxyExpand string
+
+func (e NoCollectionNameProxyExpand) expandPath() string {
+	return string(e)
+}
+
+func (e NoCollectionNameProxyExpand) Other4() Proxy4Expand {
+	return Proxy4Expand(joinExpandPath(string(e), "other4"))
+}
 `
 
 	equal, err := expectGeneratedUtils(template, expectedGeneration)
@@ -171,6 +757,191 @@ var Relations = map[string]map[string][]RelationField{
 	}
 }
 
+func TestPlaceholderStructNameUtils(t *testing.T) {
+	// The struct name contains the FieldType placeholder
+	// of the util templates
+	template := `type AllFieldTypes struct {
+	// collection-name: all_field_types
+	// system: id
+	Id             string
+	singleRelation *AllFieldTypes
+	multiRelation  []*AllFieldTypes
+}
+`
+	template = addBoilerplate(template)
+	parser, err := NewTemplateParser([]byte(template))
+	if err != nil {
+		t.Fatalf("Error during parsing: %v", err)
+	}
+	proxies, err := Generate(parser, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during generation: %v", err)
+	}
+	utils, err := GenerateUtils(parser, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during generation of utils: %v", err)
+	}
+
+	if err := typeCheck(proxies, utils); err != nil {
+		t.Fatalf("the generated utils of a struct with a placeholder in its name do not compile: %v", err)
+	}
+}
+
+func TestMultiFieldFilters(t *testing.T) {
+	template := addBoilerplate(`type Post struct {
+	// collection-name: posts
+	// system: id
+	id string
+	title string
+	// select: Tag(news, tech)[TagNews, TagTech]
+	tags []int
+}
+`)
+
+	runGeneratedTests(t, template, multiFieldFiltersTest)
+}
+
+const multiFieldFiltersTest = `package generatedtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tests"
+)
+
+func TestRenderedFilters(t *testing.T) {
+	cases := []struct {
+		filter Filter
+		expr   string
+		params dbx.Params
+	}{
+		{PostFilter.Title.Eq("a"), "title = {:p0}", dbx.Params{"p0": "a"}},
+		{PostFilter.Tags.Eq(TagNews), "tags:each ?= {:p0}", dbx.Params{"p0": "news"}},
+		{PostFilter.Tags.Neq(TagNews), "tags:each != {:p0}", dbx.Params{"p0": "news"}},
+		{PostFilter.Tags.Like(TagNews), "tags:each ?~ {:p0}", dbx.Params{"p0": "news"}},
+		{PostFilter.Tags.NotLike(TagNews), "tags:each !~ {:p0}", dbx.Params{"p0": "news"}},
+		{PostFilter.Tags.Has(TagTech), "tags:each ?= {:p0}", dbx.Params{"p0": "tech"}},
+		{
+			PostFilter.Tags.In(TagNews, TagTech),
+			"(tags:each ?= {:p0} || tags:each ?= {:p1})",
+			dbx.Params{"p0": "news", "p1": "tech"},
+		},
+		{
+			PostFilter.Tags.NotIn(TagNews, TagTech),
+			"(tags:each != {:p0} && tags:each != {:p1})",
+			dbx.Params{"p0": "news", "p1": "tech"},
+		},
+	}
+
+	for _, c := range cases {
+		expr, params := c.filter.Build()
+		if expr != c.expr || !reflect.DeepEqual(params, c.params) {
+			t.Errorf("expected %q %v, got %q %v", c.expr, c.params, expr, params)
+		}
+	}
+}
+
+func TestMultiFieldFilterMatches(t *testing.T) {
+	app, err := tests.NewTestApp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer app.Cleanup()
+
+	collection := core.NewBaseCollection("posts")
+	collection.Fields.Add(&core.TextField{Name: "title"})
+	collection.Fields.Add(&core.SelectField{Name: "tags", Values: []string{"news", "tech"}, MaxSelect: 2})
+	if err := app.Save(collection); err != nil {
+		t.Fatal(err)
+	}
+	for title, tags := range map[string][]Tag{"both": {TagNews, TagTech}, "news": {TagNews}, "none": nil} {
+		post, err := NewProxy[Post](app)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post.SetTitle(title)
+		post.SetTags(tags)
+		if err := app.Save(post); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		filter Filter
+		titles []string
+	}{
+		{PostFilter.Tags.Eq(TagNews), []string{"both", "news"}},
+		{PostFilter.Tags.Eq(TagTech), []string{"both"}},
+		{PostFilter.Tags.Neq(TagTech), []string{"news", "none"}},
+		{PostFilter.Tags.In(TagTech), []string{"both"}},
+		{PostFilter.Tags.NotIn(TagNews, TagTech), []string{"none"}},
+	}
+	for _, c := range cases {
+		expr, params := c.filter.Build()
+		posts, err := FindAllByFilter[Post](app, expr, "title", 0, 0, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		titles := make([]string, len(posts))
+		for i, post := range posts {
+			titles[i] = post.Title()
+		}
+		if !reflect.DeepEqual(titles, c.titles) {
+			t.Errorf("%v matched %v instead of %v", expr, titles, c.titles)
+		}
+	}
+}
+`
+
+// Type checks the generated files as one package
+func typeCheck(sources ...[]byte) error {
+	fset := token.NewFileSet()
+	files := make([]*ast.File, len(sources))
+	for i, source := range sources {
+		f, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		files[i] = f
+	}
+
+	imports := packageImporter{}
+	paths := make([]string, 0)
+	for _, f := range files {
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			paths = append(paths, path)
+		}
+	}
+	// The imports are loaded together so that
+	// their shared dependencies are identical
+	conf := &packages.Config{Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps}
+	pkgs, err := packages.Load(conf, paths...)
+	if err != nil {
+		return err
+	}
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		imports[pkg.PkgPath] = pkg.Types
+	})
+
+	typesConf := types.Config{Importer: imports}
+	_, err = typesConf.Check("test", fset, files, nil)
+	return err
+}
+
+type packageImporter map[string]*types.Package
+
+func (i packageImporter) Import(path string) (*types.Package, error) {
+	pkg, ok := i[path]
+	if !ok {
+		return nil, fmt.Errorf("package %v was not loaded", path)
+	}
+	return pkg, nil
+}
+
 func expectGeneratedUtils(input, expectedOutput string) (bool, error) {
 	input = addBoilerplate(input)
 
@@ -0,0 +1,75 @@
+package generator
+
+// DO NOT EDIT
+// (unless you know what you are doing of course)
+//
+// Every declaration in this template is a method of the
+// proxies of auth collections. They delegate to the auth
+// methods of core.Record with the exact same signatures
+// which makes them safe to shadow.
+var proxyAuthTemplateCode = `package template
+
+func (p *StructName) Email() string {
+	return p.Record.Email()
+}
+
+func (p *StructName) SetEmail(email string) {
+	p.Record.SetEmail(email)
+}
+
+func (p *StructName) EmailVisibility() bool {
+	return p.Record.EmailVisibility()
+}
+
+func (p *StructName) SetEmailVisibility(visible bool) {
+	p.Record.SetEmailVisibility(visible)
+}
+
+func (p *StructName) Verified() bool {
+	return p.Record.Verified()
+}
+
+func (p *StructName) SetVerified(verified bool) {
+	p.Record.SetVerified(verified)
+}
+
+func (p *StructName) SetPassword(password string) {
+	p.Record.SetPassword(password)
+}
+
+func (p *StructName) SetRandomPassword() string {
+	return p.Record.SetRandomPassword()
+}
+
+func (p *StructName) ValidatePassword(password string) bool {
+	return p.Record.ValidatePassword(password)
+}
+
+func (p *StructName) RefreshTokenKey() {
+	p.Record.RefreshTokenKey()
+}
+
+func (p *StructName) NewAuthToken() (string, error) {
+	return p.Record.NewAuthToken()
+}
+
+func (p *StructName) NewStaticAuthToken(duration time.Duration) (string, error) {
+	return p.Record.NewStaticAuthToken(duration)
+}
+
+func (p *StructName) NewVerificationToken() (string, error) {
+	return p.Record.NewVerificationToken()
+}
+
+func (p *StructName) NewPasswordResetToken() (string, error) {
+	return p.Record.NewPasswordResetToken()
+}
+
+func (p *StructName) NewEmailChangeToken(newEmail string) (string, error) {
+	return p.Record.NewEmailChangeToken(newEmail)
+}
+
+func (p *StructName) NewFileToken() (string, error) {
+	return p.Record.NewFileToken()
+}
+`
@@ -111,40 +111,63 @@ func newProxyEnrichEventFromRecordEnrichEvent[P Proxy, PP ProxyP[P]](re *core.Re
 }
 
 func syncProxyRequestEventWithRecordRequestEvent[P Proxy, PP ProxyP[P]](pe *ProxyRecordRequestEvent[P, PP], re *core.RecordRequestEvent) {
-	pe.App = re.App
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
 }
 
 func syncRecordRequestEventWithProxyRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordRequestEvent, pe *ProxyRecordRequestEvent[P, PP]) {
-	re.App = pe.App
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
 }
 
 func newProxyRequestEventFromRecordRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordRequestEvent) *ProxyRecordRequestEvent[P, PP] {
 	pe := &ProxyRecordRequestEvent[P, PP]{}
 
-	pe.RequestEvent = re.RequestEvent
-	pe.Collection = re.Collection
-	pe.PRecord, _ = WrapRecord[P, PP](re.Record)
+	syncProxyRequestEventWithRecordRequestEvent(pe, re)
 
 	return pe
 }
 
 func syncProxyListRequestEventWithRecordListRequestEvent[P Proxy, PP ProxyP[P]](pe *ProxyRecordsListRequestEvent[P, PP], re *core.RecordsListRequestEvent) {
-	pe.App = re.App
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecords = make([]PP, len(re.Records))
+	for i, r := range re.Records {
+		pe.PRecords[i] = wrapEventRecord[P, PP](r)
+	}
+	pe.Result = re.Result
 }
 
 func syncRecordListRequestEventWithProxyListRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordsListRequestEvent, pe *ProxyRecordsListRequestEvent[P, PP]) {
-	re.App = pe.App
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Records = make([]*core.Record, len(pe.PRecords))
+	for i, p := range pe.PRecords {
+		re.Records[i] = unwrapEventRecord[P, PP](p)
+	}
+	re.Result = pe.Result
+	syncListResultItems(re.Result, re.Records)
+}
+
+// The list result holds the same records as the event so a
+// replaced proxy list has to reach the response as well.
+// Items that were replaced by something other than records are kept.
+func syncListResultItems(result *search.Result, records []*core.Record) {
+	if result == nil {
+		return
+	}
+	switch result.Items.(type) {
+	case []*core.Record, *[]*core.Record:
+		result.Items = records
+	}
 }
 
 func newProxyListRequestEventFromRecordListRequestEvent[P Proxy, PP ProxyP[P]](re *core.RecordsListRequestEvent) *ProxyRecordsListRequestEvent[P, PP] {
 	pe := &ProxyRecordsListRequestEvent[P, PP]{}
 
-	pe.RequestEvent = re.RequestEvent
-	pe.Collection = re.Collection
-	pe.PRecords = make([]PP, len(re.Records))
-	for i, r := range re.Records {
-		pe.PRecords[i], _ = WrapRecord[P, PP](r)
-	}
+	syncProxyListRequestEventWithRecordListRequestEvent(pe, re)
 
 	return pe
 }
@@ -253,4 +276,361 @@ func registerProxyRequestEventHook[P Proxy, PP ProxyP[P]](
 		Priority: -99,
 	})
 }
+
+// Wraps the record of an event. Not every event
+// carries a record so nil is passed through.
+func wrapEventRecord[P Proxy, PP ProxyP[P]](record *core.Record) PP {
+	if record == nil {
+		return nil
+	}
+	p, _ := WrapRecord[P, PP](record)
+	return p
+}
+
+func unwrapEventRecord[P Proxy, PP ProxyP[P]](p PP) *core.Record {
+	if p == nil {
+		return nil
+	}
+	return p.ProxyRecord()
+}
+
+// Implemented by the proxy versions of the auth request
+// events to sync them with their PocketBase counterpart
+type proxyAuthEvent[E hook.Tagger, PE any] interface {
+	*PE
+	hook.Resolver
+	syncWithRecordEvent(re E)
+	syncRecordEvent(re E)
+}
+
+func registerProxyAuthEventHook[E hook.Tagger, PE any, PEP proxyAuthEvent[E, PE]](
+	recordHook *hook.TaggedHook[E],
+	proxyHook *hook.Hook[PEP],
+) {
+	recordHook.Bind(&hook.Handler[E]{
+		Func: func(re E) error {
+			var pe PEP = new(PE)
+			pe.syncWithRecordEvent(re)
+			err := proxyHook.Trigger(pe,
+				func(pe PEP) error {
+					pe.syncRecordEvent(re)
+					defer pe.syncWithRecordEvent(re)
+					return re.Next()
+				},
+			)
+			pe.syncRecordEvent(re)
+			return err
+		},
+		Priority: -99,
+	})
+}
+
+type ProxyRecordAuthRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	Token      string
+	Meta       any
+	AuthMethod string
+}
+
+func (pe *ProxyRecordAuthRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.Token = re.Token
+	pe.Meta = re.Meta
+	pe.AuthMethod = re.AuthMethod
+}
+
+func (pe *ProxyRecordAuthRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.Token = pe.Token
+	re.Meta = pe.Meta
+	re.AuthMethod = pe.AuthMethod
+}
+
+type ProxyRecordAuthWithPasswordRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	Identity      string
+	IdentityField string
+	Password      string
+}
+
+func (pe *ProxyRecordAuthWithPasswordRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthWithPasswordRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.Identity = re.Identity
+	pe.IdentityField = re.IdentityField
+	pe.Password = re.Password
+}
+
+func (pe *ProxyRecordAuthWithPasswordRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthWithPasswordRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.Identity = pe.Identity
+	re.IdentityField = pe.IdentityField
+	re.Password = pe.Password
+}
+
+type ProxyRecordAuthWithOAuth2RequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	ProviderName   string
+	ProviderClient auth.Provider
+	OAuth2User     *auth.AuthUser
+	CreateData     map[string]any
+	IsNewRecord    bool
+}
+
+func (pe *ProxyRecordAuthWithOAuth2RequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthWithOAuth2RequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.ProviderName = re.ProviderName
+	pe.ProviderClient = re.ProviderClient
+	pe.OAuth2User = re.OAuth2User
+	pe.CreateData = re.CreateData
+	pe.IsNewRecord = re.IsNewRecord
+}
+
+func (pe *ProxyRecordAuthWithOAuth2RequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthWithOAuth2RequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.ProviderName = pe.ProviderName
+	re.ProviderClient = pe.ProviderClient
+	re.OAuth2User = pe.OAuth2User
+	re.CreateData = pe.CreateData
+	re.IsNewRecord = pe.IsNewRecord
+}
+
+type ProxyRecordAuthRefreshRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordAuthRefreshRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthRefreshRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordAuthRefreshRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthRefreshRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordRequestPasswordResetRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordRequestPasswordResetRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordRequestPasswordResetRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordRequestPasswordResetRequestEvent[P, PP]) syncRecordEvent(re *core.RecordRequestPasswordResetRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordConfirmPasswordResetRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordConfirmPasswordResetRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordConfirmPasswordResetRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordConfirmPasswordResetRequestEvent[P, PP]) syncRecordEvent(re *core.RecordConfirmPasswordResetRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordRequestVerificationRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordRequestVerificationRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordRequestVerificationRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordRequestVerificationRequestEvent[P, PP]) syncRecordEvent(re *core.RecordRequestVerificationRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordConfirmVerificationRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+}
+
+func (pe *ProxyRecordConfirmVerificationRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordConfirmVerificationRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+}
+
+func (pe *ProxyRecordConfirmVerificationRequestEvent[P, PP]) syncRecordEvent(re *core.RecordConfirmVerificationRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+}
+
+type ProxyRecordRequestEmailChangeRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	NewEmail string
+}
+
+func (pe *ProxyRecordRequestEmailChangeRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordRequestEmailChangeRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.NewEmail = re.NewEmail
+}
+
+func (pe *ProxyRecordRequestEmailChangeRequestEvent[P, PP]) syncRecordEvent(re *core.RecordRequestEmailChangeRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.NewEmail = pe.NewEmail
+}
+
+type ProxyRecordConfirmEmailChangeRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	NewEmail string
+}
+
+func (pe *ProxyRecordConfirmEmailChangeRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordConfirmEmailChangeRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.NewEmail = re.NewEmail
+}
+
+func (pe *ProxyRecordConfirmEmailChangeRequestEvent[P, PP]) syncRecordEvent(re *core.RecordConfirmEmailChangeRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.NewEmail = pe.NewEmail
+}
+
+type ProxyRecordRequestOTPRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	Password string
+}
+
+func (pe *ProxyRecordRequestOTPRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordCreateOTPRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.Password = re.Password
+}
+
+func (pe *ProxyRecordRequestOTPRequestEvent[P, PP]) syncRecordEvent(re *core.RecordCreateOTPRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.Password = pe.Password
+}
+
+type ProxyRecordAuthWithOTPRequestEvent[P Proxy, PP ProxyP[P]] struct {
+	hook.Event
+	*core.RequestEvent
+	Collection *core.Collection
+	baseProxyEventData[P, PP]
+	OTP *core.OTP
+}
+
+func (pe *ProxyRecordAuthWithOTPRequestEvent[P, PP]) syncWithRecordEvent(re *core.RecordAuthWithOTPRequestEvent) {
+	pe.RequestEvent = re.RequestEvent
+	pe.Collection = re.Collection
+	pe.PRecord = wrapEventRecord[P, PP](re.Record)
+	pe.OTP = re.OTP
+}
+
+func (pe *ProxyRecordAuthWithOTPRequestEvent[P, PP]) syncRecordEvent(re *core.RecordAuthWithOTPRequestEvent) {
+	re.RequestEvent = pe.RequestEvent
+	re.Collection = pe.Collection
+	re.Record = unwrapEventRecord[P, PP](pe.PRecord)
+	re.OTP = pe.OTP
+}
+
+// Implemented by the field name constants of the proxy P
+type proxyField[P Proxy] interface {
+	key() string
+	fieldOf(P)
+}
+
+// The hook of the record update events of a proxy. It can
+// bind handlers that only run when a field changed.
+type ProxyUpdateHook[P Proxy, PP ProxyP[P]] struct {
+	hook.Hook[*ProxyRecordEvent[P, PP]]
+}
+
+// Binds a handler that is only called when the given field
+// changed. Otherwise the event just continues. The field
+// name constant returns the typed original and new value.
+//
+// Usage with an exemplary User proxy that has a name field:
+// 	pHooks.OnUserUpdate.WhenChanged(UserFieldName, func(e *UserEvent) error {
+// 		oldName, newName := UserFieldName.Values(e.PRecord)
+// 		fmt.Printf("%v is now called %v", oldName, newName)
+// 		return e.Next()
+// 	})
+func (h *ProxyUpdateHook[P, PP]) WhenChanged(
+	field proxyField[P],
+	handler func(e *ProxyRecordEvent[P, PP]) error,
+) string {
+	key := field.key()
+	return h.BindFunc(func(e *ProxyRecordEvent[P, PP]) error {
+		record := e.PRecord.ProxyRecord()
+		if reflect.DeepEqual(record.GetRaw(key), record.Original().GetRaw(key)) {
+			return e.Next()
+		}
+		return handler(e)
+	})
+}
 `
@@ -22,9 +22,9 @@ type ProxyHooks struct {
 	AfterCreateSuccess *hook.Hook[*Event]
 	AfterCreateError   *hook.Hook[*ErrorEvent]
 
-	Update             *hook.Hook[*Event]
-	UpdateExecute      *hook.Hook[*Event]
-	AfterUpdateSuccess *hook.Hook[*Event]
+	Update             *UpdateHook
+	UpdateExecute      *UpdateHook
+	AfterUpdateSuccess *UpdateHook
 	AfterUpdateError   *hook.Hook[*ErrorEvent]
 
 	Delete             *hook.Hook[*Event]
@@ -60,9 +60,9 @@ func NewProxyHooks(app core.App) *ProxyHooks {
 		CreateExecute:      &hook.Hook[*Event]{},
 		AfterCreateSuccess: &hook.Hook[*Event]{},
 		AfterCreateError:   &hook.Hook[*ErrorEvent]{},
-		Update:             &hook.Hook[*Event]{},
-		UpdateExecute:      &hook.Hook[*Event]{},
-		AfterUpdateSuccess: &hook.Hook[*Event]{},
+		Update:             &UpdateHook{},
+		UpdateExecute:      &UpdateHook{},
+		AfterUpdateSuccess: &UpdateHook{},
 		AfterUpdateError:   &hook.Hook[*ErrorEvent]{},
 		Delete:             &hook.Hook[*Event]{},
 		DeleteExecute:      &hook.Hook[*Event]{},
@@ -108,15 +108,15 @@ func (pHooks *ProxyHooks) registerProxyHooks(app core.App) {
 
 	registerProxyEventHook(
 		app.OnRecordUpdate("collection_name"),
-		pHooks.Update,
+		&pHooks.Update.Hook,
 	)
 	registerProxyEventHook(
 		app.OnRecordUpdateExecute("collection_name"),
-		pHooks.UpdateExecute,
+		&pHooks.UpdateExecute.Hook,
 	)
 	registerProxyEventHook(
 		app.OnRecordAfterUpdateSuccess("collection_name"),
-		pHooks.AfterUpdateSuccess,
+		&pHooks.AfterUpdateSuccess.Hook,
 	)
 	registerProxyErrorEventHook(
 		app.OnRecordAfterUpdateError("collection_name"),
@@ -161,4 +161,116 @@ func (pHooks *ProxyHooks) registerProxyHooks(app core.App) {
 		pHooks.DeleteRequest,
 	)
 }
+
+type UpdateHook = ProxyUpdateHook[StructName, *StructName]
+
+type AuthRequestEvent = ProxyRecordAuthRequestEvent[StructName, *StructName]
+type AuthWithPasswordRequestEvent = ProxyRecordAuthWithPasswordRequestEvent[StructName, *StructName]
+type AuthWithOAuth2RequestEvent = ProxyRecordAuthWithOAuth2RequestEvent[StructName, *StructName]
+type AuthRefreshRequestEvent = ProxyRecordAuthRefreshRequestEvent[StructName, *StructName]
+type RequestPasswordResetRequestEvent = ProxyRecordRequestPasswordResetRequestEvent[StructName, *StructName]
+type ConfirmPasswordResetRequestEvent = ProxyRecordConfirmPasswordResetRequestEvent[StructName, *StructName]
+type RequestVerificationRequestEvent = ProxyRecordRequestVerificationRequestEvent[StructName, *StructName]
+type ConfirmVerificationRequestEvent = ProxyRecordConfirmVerificationRequestEvent[StructName, *StructName]
+type RequestEmailChangeRequestEvent = ProxyRecordRequestEmailChangeRequestEvent[StructName, *StructName]
+type ConfirmEmailChangeRequestEvent = ProxyRecordConfirmEmailChangeRequestEvent[StructName, *StructName]
+type RequestOTPRequestEvent = ProxyRecordRequestOTPRequestEvent[StructName, *StructName]
+type AuthWithOTPRequestEvent = ProxyRecordAuthWithOTPRequestEvent[StructName, *StructName]
+
+// The hooks below only exist for auth collections
+type ProxyAuthHooks struct {
+	AuthRequest                 *hook.Hook[*AuthRequestEvent]
+	AuthWithPasswordRequest     *hook.Hook[*AuthWithPasswordRequestEvent]
+	AuthWithOAuth2Request       *hook.Hook[*AuthWithOAuth2RequestEvent]
+	AuthRefreshRequest          *hook.Hook[*AuthRefreshRequestEvent]
+	RequestPasswordResetRequest *hook.Hook[*RequestPasswordResetRequestEvent]
+	ConfirmPasswordResetRequest *hook.Hook[*ConfirmPasswordResetRequestEvent]
+	RequestVerificationRequest  *hook.Hook[*RequestVerificationRequestEvent]
+	ConfirmVerificationRequest  *hook.Hook[*ConfirmVerificationRequestEvent]
+	RequestEmailChangeRequest   *hook.Hook[*RequestEmailChangeRequestEvent]
+	ConfirmEmailChangeRequest   *hook.Hook[*ConfirmEmailChangeRequestEvent]
+	RequestOTPRequest           *hook.Hook[*RequestOTPRequestEvent]
+	AuthWithOTPRequest          *hook.Hook[*AuthWithOTPRequestEvent]
+}
+
+func NewProxyAuthHooks(app core.App) *ProxyAuthHooks {
+	pHooks := &ProxyAuthHooks{
+		AuthRequest:                 &hook.Hook[*AuthRequestEvent]{},
+		AuthWithPasswordRequest:     &hook.Hook[*AuthWithPasswordRequestEvent]{},
+		AuthWithOAuth2Request:       &hook.Hook[*AuthWithOAuth2RequestEvent]{},
+		AuthRefreshRequest:          &hook.Hook[*AuthRefreshRequestEvent]{},
+		RequestPasswordResetRequest: &hook.Hook[*RequestPasswordResetRequestEvent]{},
+		ConfirmPasswordResetRequest: &hook.Hook[*ConfirmPasswordResetRequestEvent]{},
+		RequestVerificationRequest:  &hook.Hook[*RequestVerificationRequestEvent]{},
+		ConfirmVerificationRequest:  &hook.Hook[*ConfirmVerificationRequestEvent]{},
+		RequestEmailChangeRequest:   &hook.Hook[*RequestEmailChangeRequestEvent]{},
+		ConfirmEmailChangeRequest:   &hook.Hook[*ConfirmEmailChangeRequestEvent]{},
+		RequestOTPRequest:           &hook.Hook[*RequestOTPRequestEvent]{},
+		AuthWithOTPRequest:          &hook.Hook[*AuthWithOTPRequestEvent]{},
+	}
+	pHooks.registerProxyAuthHooks(app)
+	return pHooks
+}
+
+func (pHooks *ProxyAuthHooks) registerProxyAuthHooks(app core.App) {
+	registerProxyAuthEventHook(
+		app.OnRecordAuthRequest("collection_name"),
+		pHooks.AuthRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordAuthWithPasswordRequest("collection_name"),
+		pHooks.AuthWithPasswordRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordAuthWithOAuth2Request("collection_name"),
+		pHooks.AuthWithOAuth2Request,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordAuthRefreshRequest("collection_name"),
+		pHooks.AuthRefreshRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordRequestPasswordResetRequest("collection_name"),
+		pHooks.RequestPasswordResetRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordConfirmPasswordResetRequest("collection_name"),
+		pHooks.ConfirmPasswordResetRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordRequestVerificationRequest("collection_name"),
+		pHooks.RequestVerificationRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordConfirmVerificationRequest("collection_name"),
+		pHooks.ConfirmVerificationRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordRequestEmailChangeRequest("collection_name"),
+		pHooks.RequestEmailChangeRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordConfirmEmailChangeRequest("collection_name"),
+		pHooks.ConfirmEmailChangeRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordRequestOTPRequest("collection_name"),
+		pHooks.RequestOTPRequest,
+	)
+
+	registerProxyAuthEventHook(
+		app.OnRecordAuthWithOTPRequest("collection_name"),
+		pHooks.AuthWithOTPRequest,
+	)
+}
 `
@@ -9,6 +9,7 @@ import (
 	"slices"
 
 	"github.com/iancoleman/strcase"
+	"github.com/pocketbase/pocketbase/core"
 	"golang.org/x/tools/go/ast/astutil"
 )
 
@@ -144,6 +145,9 @@ func (p *methodProxifier) up(c *astutil.Cursor) bool {
 		}
 	case *ast.RangeStmt:
 		p.proxifyRangeStmt(n)
+		if p.err != nil {
+			return false
+		}
 	}
 	return true
 }
@@ -384,6 +388,12 @@ func (p *methodProxifier) createSetterCall(expr *ast.SelectorExpr, assigned ast.
 	fieldName := expr.Sel.Name
 	setterName := setterName(fieldName)
 
+	if p.parser.collectionTypes[proxyTypeName] == core.CollectionTypeView {
+		pos := p.parser.Fset.Position(expr.Sel.Pos())
+		errMsg := fmt.Sprintf("Can not assign to the field `%v` because `%v` is a view collection. View collection proxies are read-only.", fieldName, proxyTypeName)
+		p.err = p.parser.createError(errMsg, pos, nil)
+	}
+
 	var isSelectType bool
 	proxyField, ok := p.allProxyFields[proxyTypeName][fieldName]
 	if ok {
@@ -46,6 +46,9 @@ func (p *StructName) FuncName() []*FieldType {
 // 4: Single select getter declaration
 func (p *StructName) FuncName() FieldType {
 	option := p.GetString("key")
+	if option == "" {
+		return Unset
+	}
 	i, ok := selectNameMap[option]
 	if !ok {
 		panic("Unknown select value")
@@ -104,6 +107,10 @@ func (p *StructName) FuncName(fieldName []*FieldType) {
 
 // 9: Single select setter declaration
 func (p *StructName) FuncName(fieldName FieldType) {
+	if fieldName == Unset {
+		p.Set("key", "")
+		return
+	}
 	i, ok := selectIotaMap[fieldName]
 	if !ok {
 		panic("Unknown select value")
@@ -128,4 +135,679 @@ func (p *StructName) FuncName(fieldName []FieldType) {
 func (p *StructName) FuncName() string {
 	return "key"
 }
+
+// 12: JSON getter declaration
+func (p *StructName) FuncName() FieldType {
+	var value FieldType
+	_ = p.UnmarshalJSONField("key", &value)
+	return value
+}
+
+// 13: JSON getter declaration with error
+func (p *StructName) FuncName() (FieldType, error) {
+	var value FieldType
+	if p.GetString("key") == "" {
+		return value, nil
+	}
+	err := p.UnmarshalJSONField("key", &value)
+	return value, err
+}
+
+// 14: JSON setter declaration with error
+func (p *StructName) FuncName(value FieldType) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	p.Set("key", types.JSONRaw(raw))
+	return nil
+}
+
+// 15: File upload setter declaration
+func (p *StructName) FuncName(file *filesystem.File) {
+	p.Set("key", file)
+}
+
+// 16: Multi file upload setter declaration
+func (p *StructName) FuncName(files []*filesystem.File) {
+	p.Set("key", files)
+}
+
+// 17: Multi file append declaration
+func (p *StructName) FuncName(files ...*filesystem.File) {
+	p.Set("key+", files)
+}
+
+// 18: Multi file remove declaration
+func (p *StructName) FuncName(names ...string) {
+	p.Set("key-", names)
+}
+
+// 19: File URL path declaration
+func (p *StructName) FuncName() string {
+	name := p.GetString("key")
+	if name == "" {
+		return ""
+	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name
+}
+
+// 20: File thumb URL path declaration
+func (p *StructName) FuncName(thumb string) string {
+	name := p.GetString("key")
+	if name == "" {
+		return ""
+	}
+	return "/api/files/" + p.BaseFilesPath() + "/" + name + "?thumb=" + thumb
+}
+
+// 21: Multi file URL paths declaration
+func (p *StructName) FuncName() []string {
+	names := p.GetStringSlice("key")
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = "/api/files/" + p.BaseFilesPath() + "/" + name
+	}
+	return paths
+}
+
+// 22: Multi file thumb URL paths declaration
+func (p *StructName) FuncName(thumb string) []string {
+	names := p.GetStringSlice("key")
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = "/api/files/" + p.BaseFilesPath() + "/" + name + "?thumb=" + thumb
+	}
+	return paths
+}
+
+// 23: Validate declaration
+func (p *StructName) FuncName() error {
+	errs := validation.Errors{}
+	return errs.Filter()
+}
+
+// 24: Field constraint check
+func (p *StructName) _() {
+	if value := Value; Violated {
+		errs["key"] = ConstraintError
+	}
+}
+
+// 25: File size constraint check
+func (p *StructName) _() {
+	for _, file := range p.GetUnsavedFiles("key") {
+		if err := validators.UploadedFileSize(MaxSize)(file); errs["key"] == nil && err != nil {
+			errs["key"] = err
+		}
+	}
+}
+
+// 26: Email domain constraint check
+func (p *StructName) _() {
+	if value := p.GetString("key"); errs["key"] == nil && value != "" {
+		switch value[strings.LastIndex(value, "@")+1:] {
+		case Domains:
+		default:
+			errs["key"] = validation.NewError("validation_email_domain_not_allowed", "Email domain is not allowed")
+		}
+	}
+}
+
+// 27: URL domain constraint check
+func (p *StructName) _() {
+	if u, err := url.Parse(p.GetString("key")); errs["key"] == nil && err == nil && u.Host != "" {
+		switch u.Host {
+		case Domains:
+		default:
+			errs["key"] = validation.NewError("validation_url_domain_not_allowed", "Url domain is not allowed")
+		}
+	}
+}
+
+// 28: Relation id getter declaration
+func (p *StructName) FuncName() string {
+	return p.GetString("key")
+}
+
+// 29: Relation id setter declaration
+func (p *StructName) FuncName(id string) {
+	p.Set("key", id)
+	e := p.Expand()
+	if rel, ok := e["key"].(*core.Record); ok && rel.Id != id {
+		delete(e, "key")
+		p.SetExpand(e)
+	}
+}
+
+// 30: Multi relation ids getter declaration
+func (p *StructName) FuncName() []string {
+	return p.GetStringSlice("key")
+}
+
+// 31: Multi relation ids setter declaration
+func (p *StructName) FuncName(ids []string) {
+	p.Set("key", ids)
+	e := p.Expand()
+	if _, ok := e["key"]; ok {
+		delete(e, "key")
+		p.SetExpand(e)
+	}
+}
+
+// 32: Expanded relation getter declaration
+func (p *StructName) FuncName() (*FieldType, bool) {
+	if p.GetString("key") == "" {
+		return nil, true
+	}
+	rel, ok := p.Expand()["key"].(*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxy := &FieldType{}
+	proxy.Record = rel
+	return proxy, true
+}
+
+// 33: Expanded multi relation getter declaration
+func (p *StructName) FuncName() ([]*FieldType, bool) {
+	if len(p.GetStringSlice("key")) == 0 {
+		return nil, true
+	}
+	rels, ok := p.Expand()["key"].([]*core.Record)
+	if !ok {
+		return nil, false
+	}
+	proxies := make([]*FieldType, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &FieldType{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, true
+}
+
+// 34: Relation loader declaration
+func (p *StructName) FuncName(app core.App) (*FieldType, error) {
+	id := p.GetString("key")
+	if id == "" {
+		return nil, nil
+	}
+	rel, ok := p.Expand()["key"].(*core.Record)
+	if !ok || rel.Id != id {
+		var err error
+		rel, err = app.FindRecordById("<collection>", id)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["key"] = rel
+		p.SetExpand(e)
+	}
+	proxy := &FieldType{}
+	proxy.Record = rel
+	return proxy, nil
+}
+
+// 35: Multi relation loader declaration
+func (p *StructName) FuncName(app core.App) ([]*FieldType, error) {
+	ids := p.GetStringSlice("key")
+	rels, ok := p.Expand()["key"].([]*core.Record)
+	if ok {
+		ok = len(rels) == len(ids)
+		for i := 0; ok && i < len(rels); i++ {
+			ok = rels[i].Id == ids[i]
+		}
+	}
+	if !ok {
+		records, err := app.FindRecordsByIds("<collection>", ids)
+		if err != nil {
+			return nil, err
+		}
+		byId := make(map[string]*core.Record, len(records))
+		for _, r := range records {
+			byId[r.Id] = r
+		}
+		rels = make([]*core.Record, 0, len(records))
+		for _, id := range ids {
+			if r, ok := byId[id]; ok {
+				rels = append(rels, r)
+			}
+		}
+		e := p.Expand()
+		e["key"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*FieldType, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &FieldType{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+// 36: Back relation loader declaration
+func (p *StructName) FuncName(app core.App) ([]*FieldType, error) {
+	var rels []*core.Record
+	switch expanded := p.Expand()["key"].(type) {
+	case *core.Record:
+		rels = []*core.Record{expanded}
+	case []*core.Record:
+		rels = expanded
+	default:
+		params := dbx.Params{"id": p.Id}
+		var err error
+		rels, err = app.FindRecordsByFilter("<collection>", "<filter>", "", 0, 0, params)
+		if err != nil {
+			return nil, err
+		}
+		e := p.Expand()
+		e["key"] = rels
+		p.SetExpand(e)
+	}
+	proxies := make([]*FieldType, len(rels))
+	for i := range len(rels) {
+		proxies[i] = &FieldType{}
+		proxies[i].Record = rels[i]
+	}
+	return proxies, nil
+}
+
+// 37: Single select getter with error declaration
+func (p *StructName) FuncName() (FieldType, error) {
+	option := p.GetString("key")
+	if option == "" {
+		return Unset, nil
+	}
+	i, ok := selectNameMap[option]
+	if !ok {
+		return Unset, fmt.Errorf("unknown option %q of select field %q", option, "key")
+	}
+	return i, nil
+}
+
+// 38: Multi select getter with error declaration
+func (p *StructName) FuncName() ([]FieldType, error) {
+	options := p.GetStringSlice("key")
+	is := make([]FieldType, 0, len(options))
+	for _, o := range options {
+		i, ok := selectNameMap[o]
+		if !ok {
+			return is, fmt.Errorf("unknown option %q of select field %q", o, "key")
+		}
+		is = append(is, i)
+	}
+	return is, nil
+}
+
+// 39: Single string select getter declaration
+func (p *StructName) FuncName() FieldType {
+	option := FieldType(p.GetString("key"))
+	if option != Unset && !option.IsValid() {
+		panic("Unknown select value")
+	}
+	return option
+}
+
+// 40: Multi string select getter declaration
+func (p *StructName) FuncName() []FieldType {
+	options := p.GetStringSlice("key")
+	vs := make([]FieldType, 0, len(options))
+	for _, o := range options {
+		v := FieldType(o)
+		if !v.IsValid() {
+			panic("Unknown select value")
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// 41: Single string select setter declaration
+func (p *StructName) FuncName(fieldName FieldType) {
+	if fieldName != Unset && !fieldName.IsValid() {
+		panic("Unknown select value")
+	}
+	p.Set("key", string(fieldName))
+}
+
+// 42: Multi string select setter declaration
+func (p *StructName) FuncName(fieldName []FieldType) {
+	vs := make([]string, 0, len(fieldName))
+	for _, v := range fieldName {
+		if !v.IsValid() {
+			panic("Unknown select value")
+		}
+		vs = append(vs, string(v))
+	}
+	p.Set("key", vs)
+}
+
+// 43: Single string select getter with error declaration
+func (p *StructName) FuncName() (FieldType, error) {
+	option := FieldType(p.GetString("key"))
+	if option != Unset && !option.IsValid() {
+		return Unset, fmt.Errorf("unknown option %q of select field %q", option, "key")
+	}
+	return option, nil
+}
+
+// 44: Multi string select getter with error declaration
+func (p *StructName) FuncName() ([]FieldType, error) {
+	options := p.GetStringSlice("key")
+	vs := make([]FieldType, 0, len(options))
+	for _, o := range options {
+		v := FieldType(o)
+		if !v.IsValid() {
+			return vs, fmt.Errorf("unknown option %q of select field %q", o, "key")
+		}
+		vs = append(vs, v)
+	}
+	return vs, nil
+}
+
+// 45: String select type options
+func (v StructName) Values() []StructName {
+	return AllOptions()
+}
+
+// 46: String select type validity check
+func (v StructName) IsValid() bool {
+	switch v {
+	case Options:
+		return true
+	}
+	return false
+}
+
+// 47: String select type stringer
+func (v StructName) String() string {
+	return string(v)
+}
+
+// 48: String select type text marshaler
+func (v StructName) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// 49: String select type text unmarshaler
+func (v *StructName) UnmarshalText(text []byte) error {
+	option := StructName(text)
+	if option != Unset && !option.IsValid() {
+		return fmt.Errorf("unknown option %q of select type %q", option, "StructName")
+	}
+	*v = option
+	return nil
+}
+
+// 50: Select type json marshaler
+func (v StructName) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// 51: Select type json unmarshaler
+func (v *StructName) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
+
+// 52: Select type sql scanner
+func (v *StructName) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		*v = Unset
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	}
+	return fmt.Errorf("cannot scan %T into select type %q", value, "StructName")
+}
+
+// 53: Select type sql valuer
+func (v StructName) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// 54: Iota select type stringer
+func (v StructName) String() string {
+	return selectIotaMap[v]
+}
+
+// 55: Iota select type text marshaler
+func (v StructName) MarshalText() ([]byte, error) {
+	if v == Unset {
+		return nil, nil
+	}
+	option, ok := selectIotaMap[v]
+	if !ok {
+		return nil, fmt.Errorf("unknown value %d of select type %q", v, "StructName")
+	}
+	return []byte(option), nil
+}
+
+// 56: Iota select type text unmarshaler
+func (v *StructName) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = Unset
+		return nil
+	}
+	i, ok := selectNameMap[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown option %q of select type %q", text, "StructName")
+	}
+	*v = i
+	return nil
+}
+
+// 57: All options of a select type
+func FuncName() []StructName {
+	return []StructName{Options}
+}
+
+// 58: Multi relation modifier declaration
+func (p *StructName) FuncName(fieldName ...*FieldType) {
+	ids := make([]string, len(fieldName))
+	for i, r := range fieldName {
+		ids[i] = r.Id
+	}
+	p.Record.Set("key+", ids)
+	e := p.Expand()
+	rels, ok := e["key"].([]*core.Record)
+	if !ok {
+		return
+	}
+	byId := make(map[string]*core.Record, len(rels)+len(fieldName))
+	for _, r := range rels {
+		byId[r.Id] = r
+	}
+	for _, r := range fieldName {
+		byId[r.Id] = r.Record
+	}
+	rels = make([]*core.Record, 0, len(byId))
+	for _, id := range p.GetStringSlice("key") {
+		if r, ok := byId[id]; ok {
+			rels = append(rels, r)
+		}
+	}
+	e["key"] = rels
+	p.SetExpand(e)
+}
+
+// 59: Multi relation contains check declaration
+func (p *StructName) FuncName(rel *FieldType) bool {
+	return rel != nil && slices.Contains(p.GetStringSlice("key"), rel.Id)
+}
+
+// 60: Multi select modifier declaration
+func (p *StructName) FuncName(fieldName ...FieldType) {
+	options := make([]string, 0, len(fieldName))
+	for _, v := range fieldName {
+		option, ok := selectIotaMap[v]
+		if !ok {
+			panic("Unknown select value")
+		}
+		options = append(options, option)
+	}
+	p.Set("key+", options)
+}
+
+// 61: Multi string select modifier declaration
+func (p *StructName) FuncName(fieldName ...FieldType) {
+	options := make([]string, 0, len(fieldName))
+	for _, v := range fieldName {
+		if !v.IsValid() {
+			panic("Unknown select value")
+		}
+		options = append(options, string(v))
+	}
+	p.Set("key+", options)
+}
+
+// 62: Number increment declaration
+func (p *StructName) FuncName(n FieldType) {
+	p.Set("key+", n)
+}
+
+// 63: Original value getter declaration
+func (p *StructName) FuncName() FieldType {
+	original := &StructName{}
+	original.Record = p.Original()
+	return original.GetterFuncName()
+}
+
+// 64: Field change check declaration
+func (p *StructName) FuncName() bool {
+	return !reflect.DeepEqual(p.GetRaw("key"), p.Original().GetRaw("key"))
+}
+
+// 65: Schema verification declaration
+func VerifySchema(app core.App) error {
+	var errs []error
+	errs = append(errs, verifyCollectionSchema(app, "collection_name", zzStructNameSchema))
+	return errors.Join(errs...)
+}
+
+// 66: Schema verification on bootstrap declaration
+func VerifySchemaOnBootstrap(app core.App, failOnMismatch bool) {
+	app.OnBootstrap().BindFunc(verifySchemaOnBootstrap(failOnMismatch))
+}
+
+// 67: Schema verification bootstrap handler declaration
+func verifySchemaOnBootstrap(failOnMismatch bool) func(e *core.BootstrapEvent) error {
+	return func(e *core.BootstrapEvent) error {
+		if err := e.Next(); err != nil {
+			return err
+		}
+		err := VerifySchema(e.App)
+		if err == nil || failOnMismatch {
+			return err
+		}
+		e.App.Logger().Warn("The collection schema does not match the generated proxies", "error", err)
+		return nil
+	}
+}
+
+// 68: Expected field schema type declaration
+type proxySchemaField struct {
+	name              string
+	types             []string
+	multiple          bool
+	options           []string
+	orderedOptions    bool
+	relatedCollection string
+}
+
+// 69: Collection schema verification declaration
+func verifyCollectionSchema(app core.App, collectionName string, fields []proxySchemaField) error {
+	collection, err := app.FindCachedCollectionByNameOrId(collectionName)
+	if err != nil {
+		return fmt.Errorf("collection %q could not be found: %w", collectionName, err)
+	}
+	errs := make([]error, 0, len(fields))
+	for _, f := range fields {
+		if err := f.verify(app, collection); err != nil {
+			errs = append(errs, fmt.Errorf("collection %q: field %q: %w", collection.Name, f.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// 70: Field schema verification declaration
+func (f proxySchemaField) verify(app core.App, collection *core.Collection) error {
+	field := collection.Fields.GetByName(f.name)
+	if field == nil {
+		return errors.New("does not exist")
+	}
+	if !slices.Contains(f.types, field.Type()) {
+		return fmt.Errorf("is of type %v instead of %v", field.Type(), strings.Join(f.types, " or "))
+	}
+	if multi, ok := field.(core.MultiValuer); ok && multi.IsMultiple() != f.multiple {
+		if f.multiple {
+			return errors.New("only allows a single value")
+		}
+		return errors.New("allows multiple values")
+	}
+	if selectField, ok := field.(*core.SelectField); ok && f.options != nil {
+		options := slices.Clone(selectField.Values)
+		expected := slices.Clone(f.options)
+		if !f.orderedOptions {
+			slices.Sort(options)
+			slices.Sort(expected)
+		}
+		if !slices.Equal(options, expected) {
+			return fmt.Errorf("has the select options %v instead of %v", selectField.Values, f.options)
+		}
+	}
+	if relationField, ok := field.(*core.RelationField); ok && f.relatedCollection != "" {
+		related, err := app.FindCachedCollectionByNameOrId(relationField.CollectionId)
+		if err != nil {
+			return err
+		}
+		if related.Name != f.relatedCollection && related.Id != f.relatedCollection {
+			return fmt.Errorf("relates to collection %q instead of %q", related.Name, f.relatedCollection)
+		}
+	}
+	return nil
+}
+
+// 71: Expected collection schema declaration
+var zzStructNameSchema = []proxySchemaField{}
+
+// 72: Field values declaration
+func (p *StructName) fieldValues(field string) (original any, value any) {
+	switch field {
+	case string(FieldConst):
+		return p.OriginalFuncName(), p.FuncName()
+	}
+	return nil, nil
+}
+
+// 73: Field name constants declaration
+const (
+	FieldConst ProxyField[StructName, FieldType] = "key"
+)
+
+// 74: Changed fields declaration
+func (p *StructName) ChangedFields() []string {
+	var fields []string
+	if p.FuncName() {
+		fields = append(fields, string(FieldConst))
+	}
+	return fields
+}
+
+// 75: Compiled field pattern declaration
+var zzPattern = regexp.MustCompile("pattern")
 `
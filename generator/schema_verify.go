@@ -0,0 +1,179 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+
+	"github.com/go-toolsmith/astcopy"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// Creates VerifySchema together with its helpers and the expected
+// schema of every collection. It checks the collections of the app
+// against the collections and fields that the proxies were generated
+// from. Returns nil if none of the proxies has a collection name.
+func newVerifySchemaDecls(p *Parser) []ast.Decl {
+	decl := astcopy.FuncDecl(verifySchemaTemplate)
+	decl.Doc = newDocComment("// Checks that the collections of the app still match the generated proxies")
+	checkTemplate := decl.Body.List[1]
+
+	checks := make([]ast.Stmt, 0, len(p.structSpecs))
+	schemaDecls := make([]ast.Decl, 0, len(p.structSpecs))
+	for _, s := range p.structSpecs {
+		structName := s.Name.Name
+		collectionName := p.collectionNames[structName]
+		if collectionName == "" {
+			continue
+		}
+
+		schemaDecl := newCollectionSchemaDecl(structName, collectionName, p.structFields[structName])
+		schemaDecls = append(schemaDecls, schemaDecl)
+
+		check := astcopy.Stmt(checkTemplate).(*ast.AssignStmt)
+		verifyCall := check.Rhs[0].(*ast.CallExpr).Args[1].(*ast.CallExpr)
+		verifyCall.Args[1].(*ast.BasicLit).Value = strconv.Quote(p.collectionRef(structName))
+		verifyCall.Args[2] = ast.NewIdent(collectionSchemaName(structName))
+		checks = append(checks, check)
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+	decl.Body.List = slices.Concat(decl.Body.List[:1], checks, decl.Body.List[2:])
+
+	onBootstrap := astcopy.FuncDecl(verifySchemaOnBootstrapTemplate)
+	onBootstrap.Doc = newDocComment("// Runs VerifySchema on bootstrap. Mismatches fail the startup or are logged as warnings")
+
+	decls := make([]ast.Decl, 0, len(verifySchemaUtilTemplates)+len(schemaDecls)+2)
+	decls = append(decls, decl, onBootstrap)
+	for _, template := range verifySchemaUtilTemplates {
+		decls = append(decls, astcopy.Decl(template))
+	}
+	decls = append(decls, schemaDecls...)
+
+	return decls
+}
+
+// Creates the var that holds the expected schema of the
+// non-system fields of a collection
+func newCollectionSchemaDecl(structName, collectionName string, fields []*Field) *ast.GenDecl {
+	decl := astcopy.GenDecl(collectionSchemaTemplate)
+	spec := decl.Specs[0].(*ast.ValueSpec)
+	spec.Names[0].Name = collectionSchemaName(structName)
+	decl.Doc = newDocComment(fmt.Sprintf("// The expected schema of the %v collection", collectionName))
+	fieldList := spec.Values[0].(*ast.CompositeLit)
+	for _, field := range fields {
+		// System fields are managed by PocketBase and can not drift
+		if field.systemFieldName != "" {
+			continue
+		}
+		fieldList.Elts = append(fieldList.Elts, newSchemaFieldExpr(field))
+	}
+	return decl
+}
+
+func collectionSchemaName(structName string) string {
+	// zz to keep it at the bottom of intellisense
+	return fmt.Sprintf("zz%vSchema", structName)
+}
+
+// Creates the literal of the expected schema of a field
+func newSchemaFieldExpr(field *Field) *ast.CompositeLit {
+	elts := []ast.Expr{
+		newKeyValueExpr("name", newStringLit(recordKey(field))),
+		newKeyValueExpr("types", newStringSliceLit(schemaFieldTypes(field))),
+	}
+
+	if isMultiValueField(field) {
+		elts = append(elts, newKeyValueExpr("multiple", ast.NewIdent("true")))
+	}
+	if field.selectTypeName != "" {
+		// Fields that reuse an identical select type have no options of their own
+		options := field.parser.selectTypeToOptions[field.selectTypeName]
+		elts = append(elts, newKeyValueExpr("options", newStringSliceLit(options)))
+		// The options of iota select types are stored by their index
+		if !field.isStringSelect() {
+			elts = append(elts, newKeyValueExpr("orderedOptions", ast.NewIdent("true")))
+		}
+	}
+	if field.isRelation() {
+		relatedCollection := field.parser.collectionRef(baseType(field.fieldType).Name)
+		if relatedCollection != "" {
+			elts = append(elts, newKeyValueExpr("relatedCollection", newStringLit(relatedCollection)))
+		}
+	}
+
+	return &ast.CompositeLit{Elts: elts}
+}
+
+// Returns true if the template field holds
+// multiple values of a select, file or relation
+func isMultiValueField(field *Field) bool {
+	_, isSlice := field.fieldType.(*ast.ArrayType)
+	return isSlice && field.jsonType == nil
+}
+
+// Returns the PocketBase field types that the
+// value of a template field can be read from
+func schemaFieldTypes(field *Field) []string {
+	switch {
+	case field.jsonType != nil:
+		return []string{core.FieldTypeJSON}
+	case field.isFile:
+		return []string{core.FieldTypeFile}
+	case field.selectTypeName != "":
+		return []string{core.FieldTypeSelect}
+	case field.isRelation():
+		return []string{core.FieldTypeRelation}
+	case field.constraints != nil && field.constraints.kind != "":
+		return []string{field.constraints.kind}
+	}
+
+	typeName, _ := nodeString(field.fieldType)
+	switch typeName {
+	case "bool":
+		return []string{core.FieldTypeBool}
+	case "int", "float64":
+		return []string{core.FieldTypeNumber}
+	case "string":
+		return []string{
+			core.FieldTypeText,
+			core.FieldTypeEmail,
+			core.FieldTypeURL,
+			core.FieldTypeEditor,
+			core.FieldTypePassword,
+			core.FieldTypeSelect,
+			core.FieldTypeFile,
+			core.FieldTypeJSON,
+		}
+	case "[]string":
+		return []string{core.FieldTypeSelect, core.FieldTypeFile, core.FieldTypeJSON}
+	case "types.DateTime":
+		return []string{core.FieldTypeDate, core.FieldTypeAutodate}
+	case "types.GeoPoint":
+		return []string{core.FieldTypeGeoPoint}
+	}
+
+	return []string{core.FieldTypeJSON}
+}
+
+func newKeyValueExpr(key string, value ast.Expr) *ast.KeyValueExpr {
+	return &ast.KeyValueExpr{Key: ast.NewIdent(key), Value: value}
+}
+
+func newStringLit(s string) *ast.BasicLit {
+	return &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(s)}
+}
+
+func newStringSliceLit(strs []string) *ast.CompositeLit {
+	elts := make([]ast.Expr, len(strs))
+	for i, s := range strs {
+		elts[i] = newStringLit(s)
+	}
+	return &ast.CompositeLit{
+		Type: &ast.ArrayType{Elt: ast.NewIdent("string")},
+		Elts: elts,
+	}
+}
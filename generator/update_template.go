@@ -0,0 +1,555 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pocketbase/pocketbase/core"
+	"golang.org/x/tools/imports"
+)
+
+// Merges the collections of a fresh schema into an existing template
+// and returns the updated source code. New collections and fields are
+// added, deleted ones are dropped and the select options and
+// constraint comments are updated. Renamed structs and fields, select
+// type and const names, comments and methods are kept as they are.
+func UpdateTemplate(collections []*core.Collection, templateSource []byte, savePath string) ([]byte, error) {
+	p, err := NewTemplateParser(templateSource)
+	if err != nil {
+		return nil, err
+	}
+
+	u := newTemplateUpdater(collections, p)
+	if err := u.update(); err != nil {
+		return nil, err
+	}
+
+	sourceCode, err := imports.Process(savePath, u.apply(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sourceCode, nil
+}
+
+type templateUpdater struct {
+	translator    *SchemaTranslator
+	parser        *Parser
+	collectionIds map[string]*core.Collection
+
+	// Maps the struct names that the template command
+	// would use to the struct names of the template
+	structNames map[string]string
+
+	edits    []sourceEdit
+	appended []string
+}
+
+// Replaces the source code between start and end
+type sourceEdit struct {
+	start, end  int
+	replacement string
+}
+
+// The source code of a single struct field. The doc
+// comments are kept separate so they can be edited.
+// The comments are the standalone comments above it.
+type fieldSource struct {
+	comments []string
+	doc      []string
+	line     string
+}
+
+func newTemplateUpdater(collections []*core.Collection, p *Parser) *templateUpdater {
+	translator := newSchemaTranslator(collections)
+	return &templateUpdater{
+		translator:    translator,
+		parser:        p,
+		collectionIds: translator.collectionIds,
+		structNames:   make(map[string]string, len(collections)),
+	}
+}
+
+func (u *templateUpdater) update() error {
+	p := u.parser
+
+	matches := matchCollections(p, u.translator.collections)
+	matched := make(map[*core.Collection]bool, len(matches))
+	for structName, c := range matches {
+		matched[c] = true
+		u.structNames[strcase.ToCamel(c.Name)] = structName
+	}
+
+	for _, s := range p.structSpecs {
+		if p.collectionNames[s.Name.Name] == "" {
+			continue
+		}
+		collection, ok := matches[s.Name.Name]
+		if !ok {
+			u.removeStruct(s)
+			continue
+		}
+		if err := u.updateStruct(s, collection); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range u.translator.collections {
+		if matched[c] {
+			continue
+		}
+		if err := u.addStruct(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Applies the edits to the template source code
+func (u *templateUpdater) apply() []byte {
+	sort.Slice(u.edits, func(i, j int) bool {
+		return u.edits[i].start < u.edits[j].start
+	})
+
+	source := u.parser.sourceCode
+	var sb strings.Builder
+	last := 0
+	for _, e := range u.edits {
+		sb.Write(source[last:e.start])
+		sb.WriteString(e.replacement)
+		last = e.end
+	}
+	sb.Write(source[last:])
+	for _, s := range u.appended {
+		sb.WriteString("\n")
+		sb.WriteString(s)
+		sb.WriteString("\n")
+	}
+
+	return []byte(sb.String())
+}
+
+func (u *templateUpdater) edit(start, end token.Pos, replacement string) {
+	u.edits = append(u.edits, sourceEdit{
+		start:       u.offset(start),
+		end:         u.offset(end),
+		replacement: replacement,
+	})
+}
+
+func (u *templateUpdater) offset(pos token.Pos) int {
+	return u.parser.Fset.Position(pos).Offset
+}
+
+// Removes the struct of a deleted collection together with its methods
+func (u *templateUpdater) removeStruct(spec *ast.TypeSpec) {
+	for _, decl := range u.parser.fAst.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if !slices.Contains(decl.Specs, ast.Spec(spec)) {
+				continue
+			}
+			if len(decl.Specs) == 1 {
+				u.edit(nodeStart(decl, decl.Doc), decl.End(), "")
+			} else {
+				u.edit(nodeStart(spec, spec.Doc), spec.End(), "")
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) == 0 {
+				continue
+			}
+			if baseType(decl.Recv.List[0].Type).Name == spec.Name.Name {
+				u.edit(nodeStart(decl, decl.Doc), decl.End(), "")
+			}
+		}
+	}
+}
+
+// Rewrites the fields of a struct to match its collection
+func (u *templateUpdater) updateStruct(spec *ast.TypeSpec, collection *core.Collection) error {
+	fields := u.parser.structFields[spec.Name.Name]
+	standalone := u.standaloneComments(spec.Type.(*ast.StructType))
+
+	keys := make([]string, 0, len(collection.Fields))
+	sources := make([]fieldSource, 0, len(collection.Fields))
+	var comments []string
+	for _, field := range fields {
+		start := nodeStart(field.astOriginal, field.astOriginal.Doc)
+		for len(standalone) > 0 && standalone[0].End() < start {
+			comments = append(comments, u.commentGroupSource(standalone[0]))
+			standalone = standalone[1:]
+		}
+
+		schemaField := matchField(field, collection)
+		if schemaField == nil {
+			continue
+		}
+		source, err := u.updateField(collection, field, schemaField)
+		if err != nil {
+			return err
+		}
+		source.comments = comments
+		comments = nil
+		keys = append(keys, schemaField.GetName())
+		sources = append(sources, source)
+	}
+	for _, group := range standalone {
+		comments = append(comments, u.commentGroupSource(group))
+	}
+
+	for i, schemaField := range collection.Fields {
+		if slices.Contains(keys, schemaField.GetName()) {
+			continue
+		}
+		source, err := u.newFieldSource(collection, schemaField)
+		if err != nil {
+			return err
+		}
+		// Insert after the closest preceding field of the schema
+		insertAt := 0
+		for j := i - 1; j >= 0; j-- {
+			if k := slices.Index(keys, collection.Fields[j].GetName()); k >= 0 {
+				insertAt = k + 1
+				break
+			}
+		}
+		keys = slices.Insert(keys, insertAt, schemaField.GetName())
+		sources = slices.Insert(sources, insertAt, source)
+	}
+
+	if len(sources) > 0 {
+		sources[0].doc = slices.Concat(collectionComments(collection), sources[0].doc)
+	}
+	u.edit(spec.Type.Pos(), spec.Type.End(), structSource(sources, comments))
+
+	return nil
+}
+
+// Returns the comment groups inside of a struct
+// that do not belong to one of its fields
+func (u *templateUpdater) standaloneComments(structType *ast.StructType) []*ast.CommentGroup {
+	fieldComments := make(map[*ast.CommentGroup]bool)
+	for _, f := range structType.Fields.List {
+		fieldComments[f.Doc] = true
+		fieldComments[f.Comment] = true
+	}
+
+	var groups []*ast.CommentGroup
+	for _, group := range u.parser.fAst.Comments {
+		if group.Pos() < structType.Fields.Opening || group.End() > structType.Fields.Closing {
+			continue
+		}
+		if !fieldComments[group] {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func (u *templateUpdater) commentGroupSource(group *ast.CommentGroup) string {
+	return string(u.parser.sourceCode[u.offset(group.Pos()):u.offset(group.End())])
+}
+
+// Keeps the source of a field unless its type changed. The options
+// of the '// select:' comment, the name of a renamed field in the
+// '// schema-name:' comment and the constraint comments are updated.
+// A field whose type changed is translated again but keeps its
+// comments and the type and const names of a select that stays one.
+func (u *templateUpdater) updateField(collection *core.Collection, field *Field, schemaField core.Field) (fieldSource, error) {
+	astField := field.astOriginal
+	doc := make([]string, 0, 4)
+	userDoc := make([]string, 0, 4)
+	schemaNameDoc := ""
+	selectDoc := ""
+	selectUnknownDoc := ""
+	if astField.Doc != nil {
+		for _, c := range astField.Doc.List {
+			if isCollectionComment(c.Text) {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(c.Text, schemaNameComment):
+				schemaNameDoc = c.Text
+			case strings.HasPrefix(c.Text, selectUnknownComment):
+				selectUnknownDoc = c.Text
+			case strings.HasPrefix(c.Text, selectTypeComment):
+				selectDoc = c.Text
+			case !isFieldDirective(c.Text):
+				userDoc = append(userDoc, c.Text)
+			}
+			doc = append(doc, c.Text)
+		}
+	}
+
+	if checkFieldType(field, schemaField, u.collectionIds) != "" {
+		f, err := u.translator.translateField(collection, schemaField)
+		if err != nil {
+			return fieldSource{}, err
+		}
+		f.Names[0].Name = field.fieldName
+		selectField, isSelect := schemaField.(*core.SelectField)
+		if isSelect && field.isStringSelect() {
+			baseType(f.Type).Name = "string"
+		}
+		source := u.astFieldSource(f)
+		if isSelect && selectDoc != "" {
+			for i, text := range source.doc {
+				if !strings.HasPrefix(text, selectTypeComment) {
+					continue
+				}
+				updated, err := u.updateSelectComment(collection, selectField, selectDoc)
+				if err != nil {
+					return fieldSource{}, err
+				}
+				source.doc[i] = updated
+				if selectUnknownDoc != "" {
+					source.doc = slices.Insert(source.doc, i+1, selectUnknownDoc)
+				}
+				break
+			}
+		}
+		if schemaNameDoc != "" {
+			source.doc = append([]string{schemaNameDoc}, source.doc...)
+		}
+		source.doc = updateSchemaNameComment(source.doc, field, schemaField)
+		source.doc = slices.Concat(userDoc, source.doc)
+		if astField.Comment != nil {
+			source.line += " " + u.commentGroupSource(astField.Comment)
+		}
+		return source, nil
+	}
+
+	doc = updateSchemaNameComment(doc, field, schemaField)
+	if field.fieldId == "" {
+		if c := createFieldIdComment(schemaField); c != nil {
+			doc = append(doc, c.Text)
+		}
+	}
+	doc = updateConstraintComments(doc, collection, schemaField)
+
+	if checkSelectOptions(field, schemaField) != "" {
+		for i, text := range doc {
+			if !strings.HasPrefix(text, selectTypeComment) {
+				continue
+			}
+			updated, err := u.updateSelectComment(collection, schemaField.(*core.SelectField), text)
+			if err != nil {
+				return fieldSource{}, err
+			}
+			doc[i] = updated
+		}
+	}
+
+	end := astField.End()
+	if astField.Comment != nil {
+		end = astField.Comment.End()
+	}
+	line := string(u.parser.sourceCode[u.offset(astField.Pos()):u.offset(end)])
+
+	return fieldSource{doc: doc, line: line}, nil
+}
+
+// Rewrites the options of a '// select:' comment. The type name and
+// the const names of the options that still exist are kept.
+func (u *templateUpdater) updateSelectComment(collection *core.Collection, selectField *core.SelectField, comment string) (string, error) {
+	typeStr := strings.TrimSpace(comment[len(selectTypeComment):])
+	typeName, oldOptions, oldVarNames, err := u.parser.parseSelectType(token.NoPos, typeStr)
+	if err != nil {
+		return "", err
+	}
+
+	options, varNames, err := selectOptionIdentifiers(collection, selectField)
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(typeStr, "[") {
+		return selectTypeCommentText(typeName, options, nil), nil
+	}
+	for i, o := range options {
+		if j := slices.Index(oldOptions, o); j >= 0 {
+			varNames[i] = oldVarNames[j]
+		}
+	}
+
+	return selectTypeCommentText(typeName, options, varNames), nil
+}
+
+// Points the '// schema-name:' comment of a field to the current name
+// of its schema field. The comment is added when a field that kept
+// its name in the template was renamed in the schema.
+func updateSchemaNameComment(doc []string, field *Field, schemaField core.Field) []string {
+	if field.systemFieldName != "" {
+		return doc
+	}
+
+	comment := schemaNameComment + " " + schemaField.GetName()
+	for i, text := range doc {
+		if strings.HasPrefix(text, schemaNameComment) {
+			doc[i] = comment
+			return doc
+		}
+	}
+	if ident, err := validateIdentifier(schemaField.GetName()); err == nil && ident == field.fieldName {
+		return doc
+	}
+
+	return append([]string{comment}, doc...)
+}
+
+// Replaces the constraint comments of a field with the constraints
+// of its schema field. New constraint comments are put in place of
+// the old ones or before the '// field-id:' comment.
+func updateConstraintComments(doc []string, collection *core.Collection, schemaField core.Field) []string {
+	insertAt := -1
+	kept := make([]string, 0, len(doc))
+	for _, text := range doc {
+		if _, _, ok := constraintDirective(text); ok {
+			if insertAt < 0 {
+				insertAt = len(kept)
+			}
+			continue
+		}
+		kept = append(kept, text)
+	}
+	if insertAt < 0 {
+		insertAt = len(kept)
+		for i, text := range kept {
+			if strings.HasPrefix(text, fieldIdComment) {
+				insertAt = i
+				break
+			}
+		}
+	}
+
+	comments := createConstraintComments(collection, schemaField)
+	texts := make([]string, len(comments))
+	for i, c := range comments {
+		texts[i] = c.Text
+	}
+
+	return slices.Insert(kept, insertAt, texts...)
+}
+
+// Adds the struct of a new collection to the end of the template
+func (u *templateUpdater) addStruct(collection *core.Collection) error {
+	spec, err := u.translator.collectionToStruct(collection)
+	if err != nil {
+		return err
+	}
+
+	fields := spec.Type.(*ast.StructType).Fields.List
+	sources := make([]fieldSource, len(fields))
+	for i, f := range fields {
+		sources[i] = u.astFieldSource(f)
+	}
+	u.appended = append(u.appended, "type "+spec.Name.Name+" "+structSource(sources, nil))
+
+	return nil
+}
+
+func (u *templateUpdater) newFieldSource(collection *core.Collection, schemaField core.Field) (fieldSource, error) {
+	f, err := u.translator.translateField(collection, schemaField)
+	if err != nil {
+		return fieldSource{}, err
+	}
+	return u.astFieldSource(f), nil
+}
+
+// Converts a translated field while pointing the
+// relations to the renamed structs of the template
+func (u *templateUpdater) astFieldSource(f *ast.Field) fieldSource {
+	ast.Inspect(f.Type, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if structName, ok := u.structNames[ident.Name]; ok {
+				ident.Name = structName
+			}
+		}
+		return true
+	})
+
+	doc := make([]string, 0, len(f.Doc.List))
+	for _, c := range f.Doc.List {
+		doc = append(doc, c.Text)
+	}
+	typeStr, _ := nodeString(f.Type)
+
+	return fieldSource{doc: doc, line: f.Names[0].Name + " " + typeStr}
+}
+
+// Prints the struct fields followed by the trailing comments
+func structSource(sources []fieldSource, comments []string) string {
+	var sb strings.Builder
+	sb.WriteString("struct {\n")
+	for _, s := range sources {
+		for _, c := range s.comments {
+			sb.WriteString("\n")
+			sb.WriteString(c)
+			sb.WriteString("\n\n")
+		}
+		for _, d := range s.doc {
+			sb.WriteString(d)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(s.line)
+		sb.WriteString("\n")
+	}
+	for _, c := range comments {
+		sb.WriteString("\n")
+		sb.WriteString(c)
+		sb.WriteString("\n")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Returns the comments that the template puts on the first struct field
+func collectionComments(collection *core.Collection) []string {
+	comments := createCollectionComments(collection)
+	texts := make([]string, len(comments))
+	for i, c := range comments {
+		texts[i] = c.Text
+	}
+	return texts
+}
+
+func isCollectionComment(text string) bool {
+	return strings.HasPrefix(text, collectionNameComment) ||
+		strings.HasPrefix(text, collectionIdComment) ||
+		strings.HasPrefix(text, collectionTypeComment) ||
+		strings.HasPrefix(text, viewQueryComment)
+}
+
+// Returns true for the comments of a field that the parser reads
+func isFieldDirective(text string) bool {
+	if _, _, ok := constraintDirective(text); ok {
+		return true
+	}
+	directives := []string{
+		selectTypeComment,
+		selectUnknownComment,
+		schemaNameComment,
+		systemFieldComment,
+		jsonTypeComment,
+		fileComment,
+		fieldIdComment,
+	}
+	for _, d := range directives {
+		if strings.HasPrefix(text, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the start of a node including its doc comment
+func nodeStart(n ast.Node, doc *ast.CommentGroup) token.Pos {
+	if doc != nil {
+		return doc.Pos()
+	}
+	return n.Pos()
+}
@@ -0,0 +1,251 @@
+package generator_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/nedieyassin/pocketbase-gogen/generator"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+var expectedUpdatedTemplate = `package test
+
+import (
+	"strings"
+
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+type AuthCollection struct {
+	// collection-name: members
+	// collection-id: _pb_users_auth_
+	// collection-type: auth
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// system: password
+	// field-id: password901924565
+	password string
+	// system: tokenKey
+	// field-id: text2504183744
+	tokenKey string
+	// system: email
+	// field-id: email3885137012
+	email string
+	// system: emailVisibility
+	// field-id: bool1547992806
+	emailVisibility bool
+	// system: verified
+	// field-id: bool256245529
+	verified bool
+	// max: 100
+	// field-id: text1579384326
+	name string
+	// file:
+	// field-id: file376926767
+	avatar string
+	// field-id: autodate2990389176
+	created types.DateTime
+	// field-id: autodate3332085495
+	updated types.DateTime
+}
+
+type Everything struct {
+	// collection-name: all_field_types
+	// collection-id: pbc_856668217
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// required:
+	// field-id: text1579384326
+	// schema-name: content
+	body string // The main text
+
+	// Keep this standalone comment
+
+	// file:
+	// field-id: file2388079447
+	oneFile string
+	// field-id: text_subtitle
+	subtitle string
+	// file:
+	// max-select: 99
+	// field-id: file2702582153
+	manyFiles []string
+	// field-id: number1587350850
+	floatingNumber float64
+	// field-id: number139866725
+	intergerNumber int
+	// field-id: bool2232122523
+	boolValue string
+	// field-id: email3885137012
+	email string
+	// schema-name: website
+	// field-id: url4101391790
+	url string
+	// field-id: date2862495610
+	date types.DateTime
+	// select: Grade(optionA, optionC, optionF)[GradeA, GradeC, AllFieldTypesSingleSelectoptionF]
+	// field-id: select1474461083
+	singleSelect int
+	// select: Letter(optionD, optionG)
+	// max-select: 2
+	// field-id: select2438500022
+	multiSelect []int
+	// field-id: json1795630405
+	json string
+	// field-id: relation1437456673
+	singleRelation *Everything
+	// max-select: 999
+	// field-id: relation1362355922
+	multiRelation []*Everything
+	// field-id: autodate2990389176
+	created types.DateTime
+	// field-id: autodate3332085495
+	updated types.DateTime
+}
+
+// Returns the body in upper case
+func (e *Everything) Shout() string {
+	// Keep this comment
+	return strings.ToUpper(e.body)
+}
+
+type Posts struct {
+	// collection-name: posts
+	// collection-id: posts
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// field-id: relation_source
+	source *Everything
+}
+`
+
+func TestUpdateTemplate(t *testing.T) {
+	collections, err := QuerySchema("./db_test/test_pb_data", false)
+	if err != nil {
+		t.Fatalf("Error during schema query: %v", err)
+	}
+
+	template, err := Template(collections, ".", "test")
+	if err != nil {
+		t.Fatalf("Error during template generation: %v", err)
+	}
+
+	edited := strings.NewReplacer(
+		"type AllFieldTypes struct", "type Everything struct",
+		"*AllFieldTypes", "*Everything",
+		"\ttext string\n", "\t// schema-name: text\n\tbody string // The main text\n\n\t// Keep this standalone comment\n\n",
+		"AllFieldTypesSingleSelectOptions(optionA, optionB, optionC)[AllFieldTypesSingleSelectoptionA, AllFieldTypesSingleSelectoptionB, AllFieldTypesSingleSelectoptionC]",
+		"Grade(optionA, optionB, optionC)[GradeA, GradeB, GradeC]",
+		"AllFieldTypesMultiSelectOptions(optionD, optionE)[AllFieldTypesMultiSelectoptionD, AllFieldTypesMultiSelectoptionE]",
+		"Letter(optionD, optionE)",
+	).Replace(string(template))
+	edited += `
+// Returns the body in upper case
+func (e *Everything) Shout() string {
+	// Keep this comment
+	return strings.ToUpper(e.body)
+}
+
+func (w *WithReservedGoNames) Describe() string {
+	return w.type_
+}
+`
+
+	var allFieldTypes *core.Collection
+	var updatedCollections []*core.Collection
+	for _, c := range collections {
+		switch c.Name {
+		case "all_field_types":
+			allFieldTypes = c
+		case "auth_collection":
+			c.Name = "members"
+			c.Fields.GetByName("name").(*core.TextField).Max = 100
+		case "with_reserved_go_names":
+			continue
+		}
+		updatedCollections = append(updatedCollections, c)
+	}
+
+	allFieldTypes.Fields.RemoveByName("richText")
+	allFieldTypes.Fields.RemoveByName("boolValue")
+	allFieldTypes.Fields.AddAt(7, &core.TextField{Id: "bool2232122523", Name: "boolValue"})
+	allFieldTypes.Fields.AddAt(3, &core.TextField{Id: "text_subtitle", Name: "subtitle"})
+	allFieldTypes.Fields.GetByName("url").SetName("website")
+	allFieldTypes.Fields.GetByName("text").SetName("content")
+	allFieldTypes.Fields.GetByName("content").(*core.TextField).Required = true
+	allFieldTypes.Fields.GetByName("singleSelect").(*core.SelectField).Values = []string{"optionA", "optionC", "optionF"}
+	allFieldTypes.Fields.GetByName("multiSelect").(*core.SelectField).Values = []string{"optionD", "optionG"}
+
+	posts := core.NewBaseCollection("posts")
+	posts.Id = "posts"
+	posts.Fields.Add(&core.RelationField{Id: "relation_source", Name: "source", CollectionId: allFieldTypes.Id, MaxSelect: 1})
+	updatedCollections = append(updatedCollections, posts)
+
+	updated, err := UpdateTemplate(updatedCollections, []byte(edited), "test.go")
+	if err != nil {
+		t.Fatalf("Error during template update: %v", err)
+	}
+	updatedSource := string(updated)
+	updatedSource = updatedSource[strings.Index(updatedSource, "package test"):]
+	if updatedSource != expectedUpdatedTemplate {
+		t.Fatalf("the updated template did not match the expected template:\n%v", updatedSource)
+	}
+}
+
+func TestUpdateTemplateSelectTypeChange(t *testing.T) {
+	template := `package test
+
+type Posts struct {
+	// collection-name: posts
+	// collection-id: posts
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// The publication status
+	// select: Status(draft, published)[StatusDraft, StatusPublished]
+	// select-unknown: error
+	// field-id: select_status
+	status int // Shown in the list
+	// select: Tag(news, tech)[TagNews, TagTech]
+	// field-id: select_tags
+	tags string
+}
+`
+
+	posts := core.NewBaseCollection("posts")
+	posts.Id = "posts"
+	posts.Fields.Add(&core.SelectField{Id: "select_status", Name: "status", Values: []string{"draft", "published", "archived"}, MaxSelect: 2})
+	posts.Fields.Add(&core.SelectField{Id: "select_tags", Name: "tags", Values: []string{"news", "tech"}, MaxSelect: 2})
+
+	expectedTemplate := `package test
+
+type Posts struct {
+	// collection-name: posts
+	// collection-id: posts
+	// system: id
+	// field-id: text3208210256
+	Id string
+	// The publication status
+	// select: Status(draft, published, archived)[StatusDraft, StatusPublished, PostsStatusarchived]
+	// select-unknown: error
+	// max-select: 2
+	// field-id: select_status
+	status []int // Shown in the list
+	// select: Tag(news, tech)[TagNews, TagTech]
+	// max-select: 2
+	// field-id: select_tags
+	tags []string
+}
+`
+
+	updated, err := UpdateTemplate([]*core.Collection{posts}, []byte(template), "test.go")
+	if err != nil {
+		t.Fatalf("Error during template update: %v", err)
+	}
+	if string(updated) != expectedTemplate {
+		t.Fatalf("the updated template did not keep the select type:\n%v", string(updated))
+	}
+}
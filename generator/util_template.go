@@ -112,4 +112,389 @@ type RelationField struct {
 //   -> collection names that it is related to
 //    -> list of fields that contain the relation values
 var Relations = map[string]map[string][]RelationField{}
+
+// A PocketBase filter expression. Filters are created with the
+// typed filter builders of the proxies and can be combined:
+//
+//  filter := PersonFilter.Name.Eq("Bob").And(PersonFilter.Age.Gte(18))
+//  expr, params := filter.Build()
+//  records, err := app.FindRecordsByFilter("person", expr, "", 0, 0, params)
+type Filter struct {
+	// The comparison operator of a field filter or
+	// the logical operator of a filter group
+	op string
+	// Only set for field filters
+	field string
+	value any
+	// Only set for filter groups
+	filters []Filter
+}
+
+// Combines the filter with others so that all of them have to match
+func (f Filter) And(filters ...Filter) Filter {
+	return newFilterGroup("&&", f, filters)
+}
+
+// Combines the filter with others so that at least one of them has to match
+func (f Filter) Or(filters ...Filter) Filter {
+	return newFilterGroup("||", f, filters)
+}
+
+func newFilterGroup(op string, first Filter, others []Filter) Filter {
+	filters := make([]Filter, 0, len(others)+1)
+	filters = append(filters, first)
+	filters = append(filters, others...)
+	return Filter{
+		op:      op,
+		filters: filters,
+	}
+}
+
+// Renders the filter to the PocketBase filter syntax.
+// The returned params hold the values of the placeholders
+// in the expression. An empty && group matches every record
+// and an empty || group matches none.
+func (f Filter) Build() (string, dbx.Params) {
+	params := dbx.Params{}
+	expr := f.build(params)
+	return expr, params
+}
+
+func (f Filter) build(params dbx.Params) string {
+	if f.field != "" {
+		name := fmt.Sprintf("p%d", len(params))
+		params[name] = f.value
+		return f.field + " " + f.op + " {:" + name + "}"
+	}
+
+	switch len(f.filters) {
+	case 0:
+		if f.op == "&&" {
+			return "1 = 1"
+		}
+		return "1 = 0"
+	case 1:
+		return f.filters[0].build(params)
+	}
+
+	exprs := make([]string, len(f.filters))
+	for i, filter := range f.filters {
+		exprs[i] = filter.build(params)
+	}
+	return "(" + strings.Join(exprs, " "+f.op+" ") + ")"
+}
+
+// A typed field of a proxy filter builder. The type parameter is
+// the Go type of the field (the element type for multi fields).
+// Select type values are translated to their option strings.
+// The operators of multi fields match if any of their values
+// matches. Neq, NotLike and NotIn match if none of them does.
+type FilterField[T any] struct {
+	name    string
+	toValue func(T) any
+	isMulti bool
+}
+
+func newFilterField[T any](name string) FilterField[T] {
+	return FilterField[T]{name: name}
+}
+
+func newSelectFilterField[T comparable](name string, options map[T]string) FilterField[T] {
+	toValue := func(value T) any {
+		return options[value]
+	}
+	return FilterField[T]{name: name, toValue: toValue}
+}
+
+// Marks the field as a multi field
+func (f FilterField[T]) multi() FilterField[T] {
+	f.isMulti = true
+	return f
+}
+
+func (f FilterField[T]) Eq(value T) Filter {
+	return f.compare("=", value)
+}
+
+func (f FilterField[T]) Neq(value T) Filter {
+	return f.compare("!=", value)
+}
+
+func (f FilterField[T]) Gt(value T) Filter {
+	return f.compare(">", value)
+}
+
+func (f FilterField[T]) Gte(value T) Filter {
+	return f.compare(">=", value)
+}
+
+func (f FilterField[T]) Lt(value T) Filter {
+	return f.compare("<", value)
+}
+
+func (f FilterField[T]) Lte(value T) Filter {
+	return f.compare("<=", value)
+}
+
+func (f FilterField[T]) Like(value T) Filter {
+	return f.compare("~", value)
+}
+
+func (f FilterField[T]) NotLike(value T) Filter {
+	return f.compare("!~", value)
+}
+
+// Matches multi fields that contain the value
+func (f FilterField[T]) Has(value T) Filter {
+	return f.compare("?=", value)
+}
+
+// Matches if the field equals any of the values
+func (f FilterField[T]) In(values ...T) Filter {
+	filters := make([]Filter, len(values))
+	for i, value := range values {
+		filters[i] = f.compare("=", value)
+	}
+	return Filter{
+		op:      "||",
+		filters: filters,
+	}
+}
+
+// Matches if the field equals none of the values
+func (f FilterField[T]) NotIn(values ...T) Filter {
+	filters := make([]Filter, len(values))
+	for i, value := range values {
+		filters[i] = f.compare("!=", value)
+	}
+	return Filter{
+		op:      "&&",
+		filters: filters,
+	}
+}
+
+func (f FilterField[T]) compare(op string, value T) Filter {
+	var v any = value
+	if f.toValue != nil {
+		v = f.toValue(value)
+	}
+	field := f.name
+	if f.isMulti {
+		// Compare the single values. All of them have to match
+		// the negated operators and any of them the others.
+		field += ":each"
+		if op != "!=" && op != "!~" && !strings.HasPrefix(op, "?") {
+			op = "?" + op
+		}
+	}
+	return Filter{
+		op:    op,
+		field: field,
+		value: v,
+	}
+}
+
+var StructNameFilter = struct{}{}
+
+// Finds the record with the given id and wraps it in a proxy
+//
+//  proxy, err := FindById[ProxyType](app, id)
+func FindById[P Proxy, PP ProxyP[P]](app core.App, id string) (PP, error) {
+	record, err := app.FindRecordById(PP.CollectionName(nil), id)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecord[P, PP](record)
+}
+
+// Finds the records with the given ids and wraps them in proxies
+//
+//  proxies, err := FindByIds[ProxyType](app, ids)
+func FindByIds[P Proxy, PP ProxyP[P]](app core.App, ids []string) ([]PP, error) {
+	records, err := app.FindRecordsByIds(PP.CollectionName(nil), ids)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecords[P, PP](records)
+}
+
+// Finds the first record that matches the filter and wraps it in a proxy
+//
+//  proxy, err := FindFirstByFilter[ProxyType](app, "name = {:name}", dbx.Params{"name": name})
+func FindFirstByFilter[P Proxy, PP ProxyP[P]](app core.App, filter string, params ...dbx.Params) (PP, error) {
+	record, err := app.FindFirstRecordByFilter(PP.CollectionName(nil), filter, params...)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecord[P, PP](record)
+}
+
+// Finds all records that match the filter and wraps them in proxies.
+// A limit of 0 returns all records.
+//
+//  proxies, err := FindAllByFilter[ProxyType](app, "age > {:age}", "-created", 10, 0, dbx.Params{"age": 18})
+func FindAllByFilter[P Proxy, PP ProxyP[P]](
+	app core.App,
+	filter string,
+	sort string,
+	limit int,
+	offset int,
+	params ...dbx.Params,
+) ([]PP, error) {
+	records, err := app.FindRecordsByFilter(PP.CollectionName(nil), filter, sort, limit, offset, params...)
+	if err != nil {
+		return nil, err
+	}
+	return WrapRecords[P, PP](records)
+}
+
+// Counts the records of a proxy type that match the expressions
+//
+//  count, err := Count[ProxyType](app, dbx.HashExp{"verified": true})
+func Count[P Proxy, PP ProxyP[P]](app core.App, exprs ...dbx.Expression) (int64, error) {
+	return app.CountRecords(PP.CollectionName(nil), exprs...)
+}
+
+func FindStructNameById(app core.App, id string) (*StructName, error) {
+	return FindById[StructName](app, id)
+}
+
+func FindStructNameByIds(app core.App, ids []string) ([]*StructName, error) {
+	return FindByIds[StructName](app, ids)
+}
+
+func FindFirstStructNameByFilter(app core.App, filter string, params ...dbx.Params) (*StructName, error) {
+	return FindFirstByFilter[StructName](app, filter, params...)
+}
+
+func FindAllStructNameByFilter(
+	app core.App,
+	filter string,
+	sort string,
+	limit int,
+	offset int,
+	params ...dbx.Params,
+) ([]*StructName, error) {
+	return FindAllByFilter[StructName](app, filter, sort, limit, offset, params...)
+}
+
+func CountStructName(app core.App, exprs ...dbx.Expression) (int64, error) {
+	return Count[StructName](app, exprs...)
+}
+
+// Implemented by the typed expand paths that are
+// built from the Expand variable:
+//
+//  path := Expand.Person.Account().Owner() // "account.owner"
+type ExpandPath interface {
+	expandPath() string
+}
+
+// Expands the relations of the paths in the proxy record.
+// Returns the errors of the paths that failed to expand.
+//
+//  errs := ExpandProxy(app, person, Expand.Person.Account().Owner(), Expand.Person.Children())
+func ExpandProxy(app core.App, proxy core.RecordProxy, paths ...ExpandPath) map[string]error {
+	expands := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if p := path.expandPath(); p != "" {
+			expands = append(expands, p)
+		}
+	}
+	return app.ExpandRecord(proxy.ProxyRecord(), expands, nil)
+}
+
+func joinExpandPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+type StructNameExpand string
+
+func (e StructNameExpand) expandPath() string {
+	return string(e)
+}
+
+func (e StructNameExpand) FuncName() FieldTypeExpand {
+	return FieldTypeExpand(joinExpandPath(string(e), "key"))
+}
+
+// The roots of the typed expand paths
+var Expand = struct{}{}
+
+// Returns the auth record of the request wrapped in a proxy.
+// ok is false if the request is not authenticated or the
+// auth record belongs to another collection.
+//
+//  user, ok := AuthAs[*User](e)
+func AuthAs[PP ProxyP[P], P Proxy](e *core.RequestEvent) (PP, bool) {
+	if e.Auth == nil || e.Auth.Collection().Name != PP.CollectionName(nil) {
+		return nil, false
+	}
+	var p PP = &P{}
+	p.SetProxyRecord(e.Auth)
+	return p, true
+}
+
+// Creates a middleware that requires the request to be
+// authenticated with a record of the proxy's collection
+//
+//  se.Router.GET("/hello", handler).Bind(RequireAuthAs[*User]())
+func RequireAuthAs[PP ProxyP[P], P Proxy]() *hook.Handler[*core.RequestEvent] {
+	return &hook.Handler[*core.RequestEvent]{
+		Func: requireAuthAs(PP.CollectionName(nil)),
+	}
+}
+
+func requireAuthAs(collectionName string) func(*core.RequestEvent) error {
+	return func(e *core.RequestEvent) error {
+		if e.Auth == nil {
+			return e.UnauthorizedError("The request requires valid record authorization token.", nil)
+		}
+		if e.Auth.Collection().Name != collectionName {
+			return e.ForbiddenError("The authorized record is not allowed to perform this action.", nil)
+		}
+		return e.Next()
+	}
+}
+
+// Returns the auth record of the request if it is a StructName
+func AuthStructName(e *core.RequestEvent) (*StructName, bool) {
+	return AuthAs[*StructName](e)
+}
+
+// The name of a record field of the proxy type P whose
+// getter returns T. The field name constants of the
+// proxies have this type:
+//
+//  const PersonFieldHealth ProxyField[Person, Health] = "health"
+type ProxyField[P Proxy, T any] string
+
+// Returns the original and the current value of the field.
+// Both are zero values for fields whose original values
+// are not tracked like the fields of views.
+//
+//  oldHealth, newHealth := PersonFieldHealth.Values(person)
+func (f ProxyField[P, T]) Values(p *P) (original T, value T) {
+	tracked, ok := any(p).(interface {
+		fieldValues(field string) (original any, value any)
+	})
+	if !ok {
+		return original, value
+	}
+	o, v := tracked.fieldValues(string(f))
+	original, _ = o.(T)
+	value, _ = v.(T)
+	return original, value
+}
+
+func (f ProxyField[P, T]) key() string {
+	return string(f)
+}
+
+// Ties the field to its proxy type so that only
+// the fields of a proxy are accepted for its hooks
+func (f ProxyField[P, T]) fieldOf(P) {}
 `